@@ -3,14 +3,14 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/mitchellh/cli"
 	"github.com/ryanuber/columnize"
 	"github.com/umbracle/viewpoint/internal/cmd/server"
 	"github.com/umbracle/viewpoint/internal/server/proto"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Commands returns the cli commands
@@ -31,6 +31,13 @@ func Commands() map[string]cli.CommandFactory {
 				UI: ui,
 			}, nil
 		},
+		"up": func() (cli.Command, error) {
+			return &server.UpCommand{
+				Command: server.Command{
+					UI: ui,
+				},
+			}, nil
+		},
 		"node deploy validator": func() (cli.Command, error) {
 			return &NodeDeployValidatorCommand{
 				Meta: meta,
@@ -41,6 +48,11 @@ func Commands() map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"node deploy execution": func() (cli.Command, error) {
+			return &NodeDeployExecutionCommand{
+				Meta: meta,
+			}, nil
+		},
 		"node list": func() (cli.Command, error) {
 			return &NodeListCommand{
 				Meta: meta,
@@ -51,6 +63,31 @@ func Commands() map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"node stop": func() (cli.Command, error) {
+			return &NodeStopCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node start": func() (cli.Command, error) {
+			return &NodeStartCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node restart": func() (cli.Command, error) {
+			return &NodeRestartCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node rm": func() (cli.Command, error) {
+			return &NodeRemoveCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node migrate": func() (cli.Command, error) {
+			return &NodeMigrateCommand{
+				Meta: meta,
+			}, nil
+		},
 		"deposit create": func() (cli.Command, error) {
 			return &DepositCreateCommand{
 				Meta: meta,
@@ -61,6 +98,26 @@ func Commands() map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"deposit export": func() (cli.Command, error) {
+			return &DepositExportCommand{
+				Meta: meta,
+			}, nil
+		},
+		"deposit import": func() (cli.Command, error) {
+			return &DepositImportCommand{
+				Meta: meta,
+			}, nil
+		},
+		"deposit exit": func() (cli.Command, error) {
+			return &DepositExitCommand{
+				Meta: meta,
+			}, nil
+		},
+		"monitor": func() (cli.Command, error) {
+			return &MonitorCommand{
+				Meta: meta,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				UI: ui,
@@ -69,20 +126,48 @@ func Commands() map[string]cli.CommandFactory {
 	}
 }
 
+const (
+	// envAddr is the env variable with the address of the server
+	envAddr = "VIEWPOINT_ADDR"
+
+	// envCACert is the env variable with the path of the server TLS certificate
+	envCACert = "VIEWPOINT_CA_CERT"
+
+	// envToken is the env variable with the auth token of the server
+	envToken = "VIEWPOINT_TOKEN"
+)
+
 type Meta struct {
-	UI   cli.Ui
-	addr string
+	UI        cli.Ui
+	addr      string
+	caCert    string
+	tokenFile string
 }
 
 func (m *Meta) FlagSet(n string) *flag.FlagSet {
 	f := flag.NewFlagSet(n, flag.ContinueOnError)
-	f.StringVar(&m.addr, "address", "localhost:5555", "Address of the http api")
+	f.StringVar(&m.addr, "address", envOrDefault(envAddr, "localhost:5555"), "Address of the http api")
+	f.StringVar(&m.caCert, "ca-cert", os.Getenv(envCACert), "Path of the TLS certificate of the server")
+	f.StringVar(&m.tokenFile, "token-file", "", "Path of the file with the auth token")
 	return f
 }
 
 // Conn returns a grpc connection
 func (m *Meta) Conn() (proto.E2EServiceClient, error) {
-	conn, err := grpc.Dial(m.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	token := os.Getenv(envToken)
+	if m.tokenFile != "" {
+		data, err := ioutil.ReadFile(m.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token file: %v", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	config := &proto.DialConfig{
+		CAFile: m.caCert,
+		Token:  token,
+	}
+	conn, err := proto.Dial(m.addr, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %v", err)
 	}
@@ -90,6 +175,13 @@ func (m *Meta) Conn() (proto.E2EServiceClient, error) {
 	return clt, nil
 }
 
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
 func formatList(in []string) string {
 	columnConf := columnize.DefaultConfig()
 	columnConf.Empty = "<none>"
@@ -12,6 +12,15 @@ type DepositCreateCommand struct {
 	*Meta
 
 	numValidators uint64
+
+	amount            uint64
+	withdrawalType    string
+	withdrawalAddress string
+
+	topUp   bool
+	tranche uint64
+
+	invalid string
 }
 
 // Help implements the cli.Command interface
@@ -29,21 +38,73 @@ func (c *DepositCreateCommand) Run(args []string) int {
 	flags := c.FlagSet("deposit create")
 
 	flags.Uint64Var(&c.numValidators, "num-validators", 0, "")
+	flags.Uint64Var(&c.amount, "amount", 0, "")
+	flags.StringVar(&c.withdrawalType, "withdrawal-type", "bls", "")
+	flags.StringVar(&c.withdrawalAddress, "withdrawal-address", "", "")
+	flags.BoolVar(&c.topUp, "top-up", false, "")
+	flags.Uint64Var(&c.tranche, "tranche", 0, "")
+	flags.StringVar(&c.invalid, "invalid", "", "")
 
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
 
+	withdrawalType, ok := proto.StringToWithdrawalCredentials(c.withdrawalType)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("withdrawal type %s not found", c.withdrawalType))
+		return 1
+	}
+
+	var invalid proto.InvalidDeposit
+	if c.invalid != "" {
+		if invalid, ok = proto.StringToInvalidDeposit(c.invalid); !ok {
+			c.UI.Error(fmt.Sprintf("invalid deposit kind %s not found", c.invalid))
+			return 1
+		}
+	}
+
 	clt, err := c.Conn()
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
 	req := &proto.DepositCreateRequest{
-		NumValidators: c.numValidators,
+		NumValidators:         c.numValidators,
+		Amount:                c.amount,
+		WithdrawalCredentials: withdrawalType,
+		WithdrawalAddress:     c.withdrawalAddress,
+		TopUp:                 c.topUp,
+		Tranche:               c.tranche,
+		Invalid:               invalid,
 	}
+
+	// print the progress of the deposits while the tranche is created
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := clt.Subscribe(ctx, &proto.SubscribeRequest{})
+	if err != nil {
+		cancel()
+		c.UI.Error(err.Error())
+		return 1
+	}
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if obj, ok := event.Event.(*proto.Event_DepositProgress_); ok {
+				c.UI.Output(formatDepositProgress(obj.DepositProgress))
+			}
+		}
+	}()
+
 	resp, err := clt.DepositCreate(context.Background(), req)
+	cancel()
+	<-doneCh
+
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
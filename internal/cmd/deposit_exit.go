@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// DepositExitCommand is the command to exit the validators of a tranche
+type DepositExitCommand struct {
+	*Meta
+
+	indices    string
+	beaconName string
+}
+
+// Help implements the cli.Command interface
+func (c *DepositExitCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DepositExitCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *DepositExitCommand) Run(args []string) int {
+	flags := c.FlagSet("deposit exit")
+
+	flags.StringVar(&c.indices, "indices", "", "")
+	flags.StringVar(&c.beaconName, "beacon-name", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+	tranche, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("invalid tranche '%s': %v", args[0], err))
+		return 1
+	}
+
+	indices := []uint64{}
+	if c.indices != "" {
+		for _, str := range strings.Split(c.indices, ",") {
+			index, err := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("invalid index '%s': %v", str, err))
+				return 1
+			}
+			indices = append(indices, index)
+		}
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	req := &proto.DepositExitRequest{
+		Tranche: tranche,
+		Indices: indices,
+		Beacon:  c.beaconName,
+	}
+	stream, err := clt.DepositExit(context.Background(), req)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		c.UI.Output(formatExitValidators(resp.Validators) + "\n")
+	}
+
+	c.UI.Output(fmt.Sprintf("Validators of tranche %d exited", tranche))
+	return 0
+}
+
+func formatExitValidators(validators []*proto.DepositExitResponse_Validator) string {
+	rows := make([]string, len(validators)+1)
+	rows[0] = "Index|Public key|Status|Exit epoch"
+	for i, val := range validators {
+		rows[i+1] = fmt.Sprintf("%d|%s|%s|%d",
+			val.Index,
+			val.PubKey,
+			val.Status,
+			val.ExitEpoch,
+		)
+	}
+	return formatList(rows)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// DepositExportCommand is the command to export the accounts of a tranche
+type DepositExportCommand struct {
+	*Meta
+
+	format   string
+	out      string
+	password string
+}
+
+// Help implements the cli.Command interface
+func (c *DepositExportCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DepositExportCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *DepositExportCommand) Run(args []string) int {
+	flags := c.FlagSet("deposit export")
+
+	flags.StringVar(&c.format, "format", "keystores", "")
+	flags.StringVar(&c.out, "out", "", "")
+	flags.StringVar(&c.password, "password", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+	tranche, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("invalid tranche '%s': %v", args[0], err))
+		return 1
+	}
+	format, ok := proto.StringToExportFormat(c.format)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("export format '%s' not found", c.format))
+		return 1
+	}
+	out := c.out
+	if out == "" {
+		out = fmt.Sprintf("tranche_%d", tranche)
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	req := &proto.DepositExportRequest{
+		Tranche:  tranche,
+		Format:   format,
+		Password: c.password,
+	}
+	resp, err := clt.DepositExport(context.Background(), req)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if err := writeExportFiles(out, resp.Files); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	c.UI.Output(fmt.Sprintf("Exported %d files to %s", len(resp.Files), out))
+	return 0
+}
+
+// writeExportFiles writes the exported files in the output directory. The files
+// include private keys and passwords so they are only readable by the user.
+func writeExportFiles(out string, files []*proto.DepositExportResponse_File) error {
+	for _, file := range files {
+		path := filepath.Join(out, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return fmt.Errorf("failed to create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, file.Content, 0600); err != nil {
+			return fmt.Errorf("failed to write file: %v", err)
+		}
+	}
+	return nil
+}
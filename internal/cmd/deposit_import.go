@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// DepositImportCommand is the command to import validator keys in a new tranche
+type DepositImportCommand struct {
+	*Meta
+
+	password string
+}
+
+// Help implements the cli.Command interface
+func (c *DepositImportCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DepositImportCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *DepositImportCommand) Run(args []string) int {
+	flags := c.FlagSet("deposit import")
+
+	flags.StringVar(&c.password, "password", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+
+	// the keystores are decrypted locally and only the keys are sent
+	keys, err := proto.ReadKeys(args[0], c.password)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("failed to read keys: %v", err))
+		return 1
+	}
+	req := &proto.DepositImportRequest{}
+	for _, key := range keys {
+		priv, err := key.Marshal()
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		req.Keys = append(req.Keys, priv)
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	resp, err := clt.DepositImport(context.Background(), req)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(formatTranche(resp.Tranche))
+	return 0
+}
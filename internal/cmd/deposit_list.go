@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/umbracle/viewpoint/internal/server/proto"
 )
@@ -10,6 +11,9 @@ import (
 // E2EValidatorDepositCommand is the command to deploy an e2e network
 type DepositListCommand struct {
 	*Meta
+
+	accounts bool
+	deposits bool
 }
 
 // Help implements the cli.Command interface
@@ -25,6 +29,8 @@ func (c *DepositListCommand) Synopsis() string {
 // Run implements the cli.Command interface
 func (c *DepositListCommand) Run(args []string) int {
 	flags := c.FlagSet("deposit list")
+	flags.BoolVar(&c.accounts, "accounts", false, "")
+	flags.BoolVar(&c.deposits, "deposits", false, "")
 
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(err.Error())
@@ -41,7 +47,13 @@ func (c *DepositListCommand) Run(args []string) int {
 		c.UI.Error(err.Error())
 		return 1
 	}
-	c.UI.Output(formatTranches(resp.Tranches))
+	if c.accounts {
+		c.UI.Output(formatTrancheAccounts(resp.Tranches))
+	} else if c.deposits {
+		c.UI.Output(formatTrancheDeposits(resp.Tranches))
+	} else {
+		c.UI.Output(formatTranches(resp.Tranches))
+	}
 	return 0
 }
 
@@ -65,3 +77,48 @@ func formatTranches(tranches []*proto.TrancheStub) string {
 	}
 	return formatList(rows)
 }
+
+func formatTrancheAccounts(tranches []*proto.TrancheStub) string {
+	rows := []string{"Tranche|Index|Public key"}
+	for _, d := range tranches {
+		for _, acct := range d.Accounts {
+			index := "-"
+			if acct.Derived {
+				index = fmt.Sprintf("%d", acct.Index)
+			}
+			rows = append(rows, fmt.Sprintf("%d|%s|0x%s",
+				d.Index,
+				index,
+				acct.PubKey,
+			))
+		}
+	}
+	if len(rows) == 1 {
+		return "No accounts found"
+	}
+	return formatList(rows)
+}
+
+func formatTrancheDeposits(tranches []*proto.TrancheStub) string {
+	rows := []string{"Tranche|Public key|Amount|Withdrawal credentials|Invalid|Rejected"}
+	for _, d := range tranches {
+		for _, dep := range d.Deposits {
+			invalid := "-"
+			if dep.Invalid != proto.InvalidDeposit_NoInvalidDeposit {
+				invalid = strings.TrimPrefix(dep.Invalid.String(), "Invalid")
+			}
+			rows = append(rows, fmt.Sprintf("%d|0x%s|%d|0x%s|%s|%t",
+				d.Index,
+				dep.PubKey,
+				dep.Amount,
+				dep.WithdrawalCredentials,
+				invalid,
+				dep.Rejected,
+			))
+		}
+	}
+	if len(rows) == 1 {
+		return "No deposits found"
+	}
+	return formatList(rows)
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// MonitorCommand is the command to print the events of the server
+type MonitorCommand struct {
+	*Meta
+}
+
+// Help implements the cli.Command interface
+func (c *MonitorCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *MonitorCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *MonitorCommand) Run(args []string) int {
+	flags := c.FlagSet("monitor")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	stream, err := clt.Subscribe(context.Background(), &proto.SubscribeRequest{})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		c.UI.Output(formatEvent(event))
+	}
+}
+
+func formatEvent(event *proto.Event) string {
+	var msg string
+
+	switch obj := event.Event.(type) {
+	case *proto.Event_NodeDeployed_:
+		msg = fmt.Sprintf("Node deployed: %s", obj.NodeDeployed.Node.Name)
+
+	case *proto.Event_NodeReady_:
+		msg = fmt.Sprintf("Node ready: %s", obj.NodeReady.Node.Name)
+
+	case *proto.Event_NodeExited_:
+		msg = fmt.Sprintf("Node exited: %s (exit code %d)", obj.NodeExited.Node.Name, obj.NodeExited.ExitCode)
+		if obj.NodeExited.Error != "" {
+			msg += fmt.Sprintf(" (%s)", obj.NodeExited.Error)
+		}
+
+	case *proto.Event_TrancheCreated_:
+		msg = fmt.Sprintf("Tranche created: %d (%d validators)", obj.TrancheCreated.Index, obj.TrancheCreated.NumValidators)
+
+	case *proto.Event_DepositSent_:
+		msg = fmt.Sprintf("Deposit sent: %s (txn %s)", obj.DepositSent.PubKey, obj.DepositSent.TxnHash)
+
+	case *proto.Event_DepositMined_:
+		msg = fmt.Sprintf("Deposit mined: %s (block %d)", obj.DepositMined.PubKey, obj.DepositMined.BlockNumber)
+
+	case *proto.Event_DepositProgress_:
+		msg = "Deposit progress: " + formatDepositProgress(obj.DepositProgress)
+
+	case *proto.Event_GenesisWritten_:
+		msg = fmt.Sprintf("Genesis written: %s (genesis time %d)", obj.GenesisWritten.Path, obj.GenesisWritten.GenesisTime)
+
+	default:
+		msg = "Unknown event"
+	}
+
+	timestamp := time.UnixMilli(event.Time).Format(time.RFC3339)
+	return fmt.Sprintf("%s [%d] %s", timestamp, event.Index, msg)
+}
+
+func formatDepositProgress(progress *proto.Event_DepositProgress) string {
+	msg := fmt.Sprintf("funded %d/%d, sent %d/%d, mined %d/%d",
+		progress.Funded, progress.NumAccounts,
+		progress.Sent, progress.NumDeposits,
+		progress.Mined, progress.NumDeposits,
+	)
+	if progress.Resubmitted != 0 {
+		msg += fmt.Sprintf(" (%d resubmitted)", progress.Resubmitted)
+	}
+	return msg
+}
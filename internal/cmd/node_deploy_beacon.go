@@ -11,11 +11,16 @@ import (
 type NodeDeployBeaconCommand struct {
 	*Meta
 
-	count uint64
+	count         uint64
+	withExecution bool
+	executionType string
 
 	nodeType string
 	repo     string
 	tag      string
+
+	restart    string
+	maxRetries uint64
 }
 
 // Help implements the cli.Command interface
@@ -34,8 +39,12 @@ func (c *NodeDeployBeaconCommand) Run(args []string) int {
 
 	flags.StringVar(&c.nodeType, "type", "", "")
 	flags.Uint64Var(&c.count, "count", 1, "")
+	flags.BoolVar(&c.withExecution, "execution", false, "")
+	flags.StringVar(&c.executionType, "execution-type", "geth", "")
 	flags.StringVar(&c.repo, "repo", "", "")
 	flags.StringVar(&c.tag, "tag", "", "")
+	flags.StringVar(&c.restart, "restart", "never", "")
+	flags.Uint64Var(&c.maxRetries, "max-retries", 0, "")
 
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(err.Error())
@@ -52,23 +61,39 @@ func (c *NodeDeployBeaconCommand) Run(args []string) int {
 		c.UI.Error(fmt.Sprintf("node type %s not found", c.nodeType))
 		return 1
 	}
+	execTyp, ok := proto.StringToExecutionClient(c.executionType)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("execution type %s not found", c.executionType))
+		return 1
+	}
 
 	if c.count == 0 {
 		c.UI.Error("--count cannot be zero")
 		return 1
 	}
 
+	restartPolicy, ok := proto.StringToRestartPolicy(c.restart)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("restart policy %s not found", c.restart))
+		return 1
+	}
+
 	reqJob := &proto.NodeDeployRequest_Beacon_{
 		Beacon: &proto.NodeDeployRequest_Beacon{
-			Count: c.count,
+			Count:         c.count,
+			WithExecution: c.withExecution,
 		},
 	}
 
 	req := &proto.NodeDeployRequest{
-		NodeClient: typ,
-		Repo:       c.repo,
-		Tag:        c.tag,
-		NodeType:   reqJob,
+		NodeClient:      typ,
+		ExecutionClient: execTyp,
+		Repo:            c.repo,
+		Tag:             c.tag,
+		NodeType:        reqJob,
+
+		RestartPolicy: restartPolicy,
+		MaxRetries:    c.maxRetries,
 	}
 
 	if _, err := clt.NodeDeploy(context.Background(), req); err != nil {
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// NodeDeployExecutionCommand is the command to deploy execution nodes
+type NodeDeployExecutionCommand struct {
+	*Meta
+
+	count uint64
+
+	nodeType string
+	repo     string
+	tag      string
+
+	restart    string
+	maxRetries uint64
+}
+
+// Help implements the cli.Command interface
+func (c *NodeDeployExecutionCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *NodeDeployExecutionCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *NodeDeployExecutionCommand) Run(args []string) int {
+	flags := c.FlagSet("node deploy execution")
+
+	flags.StringVar(&c.nodeType, "type", "geth", "")
+	flags.Uint64Var(&c.count, "count", 1, "")
+	flags.StringVar(&c.repo, "repo", "", "")
+	flags.StringVar(&c.tag, "tag", "", "")
+	flags.StringVar(&c.restart, "restart", "never", "")
+	flags.Uint64Var(&c.maxRetries, "max-retries", 0, "")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	typ, ok := proto.StringToExecutionClient(c.nodeType)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("execution type %s not found", c.nodeType))
+		return 1
+	}
+
+	if c.count == 0 {
+		c.UI.Error("--count cannot be zero")
+		return 1
+	}
+
+	restartPolicy, ok := proto.StringToRestartPolicy(c.restart)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("restart policy %s not found", c.restart))
+		return 1
+	}
+
+	reqJob := &proto.NodeDeployRequest_Execution_{
+		Execution: &proto.NodeDeployRequest_Execution{
+			Count: c.count,
+		},
+	}
+
+	req := &proto.NodeDeployRequest{
+		ExecutionClient: typ,
+		Repo:            c.repo,
+		Tag:             c.tag,
+		NodeType:        reqJob,
+
+		RestartPolicy: restartPolicy,
+		MaxRetries:    c.maxRetries,
+	}
+
+	if _, err := clt.NodeDeploy(context.Background(), req); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	return 0
+}
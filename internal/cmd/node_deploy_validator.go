@@ -14,13 +14,21 @@ type NodeDeployValidatorCommand struct {
 	nodeType      string
 	numValidators uint64
 
-	withBeacon bool
+	withBeacon    bool
+	withExecution bool
+	executionType string
 
 	trancheNum  uint64
 	beaconCount uint64
+	beaconName  string
+
+	remoteSigner bool
 
 	repo string
 	tag  string
+
+	restart    string
+	maxRetries uint64
 }
 
 // Help implements the cli.Command interface
@@ -42,8 +50,14 @@ func (c *NodeDeployValidatorCommand) Run(args []string) int {
 	flags.Uint64Var(&c.trancheNum, "tranche", 0, "")
 	flags.BoolVar(&c.withBeacon, "beacon", false, "")
 	flags.Uint64Var(&c.beaconCount, "beacon-count", 1, "")
+	flags.BoolVar(&c.withExecution, "beacon-execution", false, "")
+	flags.StringVar(&c.executionType, "execution-type", "geth", "")
+	flags.StringVar(&c.beaconName, "beacon-name", "", "")
+	flags.BoolVar(&c.remoteSigner, "remote-signer", false, "")
 	flags.StringVar(&c.repo, "repo", "", "")
 	flags.StringVar(&c.tag, "tag", "", "")
+	flags.StringVar(&c.restart, "restart", "never", "")
+	flags.Uint64Var(&c.maxRetries, "max-retries", 0, "")
 
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(err.Error())
@@ -60,26 +74,45 @@ func (c *NodeDeployValidatorCommand) Run(args []string) int {
 		c.UI.Error(fmt.Sprintf("node type %s not found", c.nodeType))
 		return 1
 	}
+	execTyp, ok := proto.StringToExecutionClient(c.executionType)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("execution type %s not found", c.executionType))
+		return 1
+	}
 
 	if c.beaconCount == 0 {
 		c.UI.Error("--count cannot be zero")
 		return 1
 	}
 
+	restartPolicy, ok := proto.StringToRestartPolicy(c.restart)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("restart policy %s not found", c.restart))
+		return 1
+	}
+
 	reqJob := &proto.NodeDeployRequest_Validator_{
 		Validator: &proto.NodeDeployRequest_Validator{
 			NumValidators: c.numValidators,
 			NumTranch:     c.trancheNum,
 			WithBeacon:    c.withBeacon,
 			BeaconCount:   c.beaconCount,
+			Beacon:        c.beaconName,
+
+			BeaconWithExecution: c.withExecution,
+			RemoteSigner:        c.remoteSigner,
 		},
 	}
 
 	req := &proto.NodeDeployRequest{
-		NodeClient: typ,
-		Repo:       c.repo,
-		Tag:        c.tag,
-		NodeType:   reqJob,
+		NodeClient:      typ,
+		ExecutionClient: execTyp,
+		Repo:            c.repo,
+		Tag:             c.tag,
+		NodeType:        reqJob,
+
+		RestartPolicy: restartPolicy,
+		MaxRetries:    c.maxRetries,
 	}
 
 	if _, err := clt.NodeDeploy(context.Background(), req); err != nil {
@@ -52,13 +52,16 @@ func formatNodes(nodes []*proto.Node) string {
 	}
 
 	rows := make([]string, len(nodes)+1)
-	rows[0] = "Name|Type|IP|Client"
+	rows[0] = "Name|Type|IP|Client|State|Restarts|Pair"
 	for i, d := range nodes {
-		rows[i+1] = fmt.Sprintf("%s|%s|%s|%s",
+		rows[i+1] = fmt.Sprintf("%s|%s|%s|%s|%s|%d|%s",
 			d.Name,
 			d.Type.String(),
 			d.Ip,
-			d.Client.String(),
+			nodeClient(d),
+			d.State.String(),
+			d.Restarts,
+			d.Pair,
 		)
 	}
 	return formatList(rows)
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// NodeMigrateCommand is the command to migrate a validator to another client
+type NodeMigrateCommand struct {
+	*Meta
+
+	nodeType   string
+	beaconName string
+	output     string
+
+	repo string
+	tag  string
+
+	restart    string
+	maxRetries uint64
+}
+
+// Help implements the cli.Command interface
+func (c *NodeMigrateCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *NodeMigrateCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *NodeMigrateCommand) Run(args []string) int {
+	flags := c.FlagSet("node migrate")
+
+	flags.StringVar(&c.nodeType, "type", "", "")
+	flags.StringVar(&c.beaconName, "beacon-name", "", "")
+	flags.StringVar(&c.output, "slashing-protection", "", "")
+	flags.StringVar(&c.repo, "repo", "", "")
+	flags.StringVar(&c.tag, "tag", "", "")
+	flags.StringVar(&c.restart, "restart", "never", "")
+	flags.Uint64Var(&c.maxRetries, "max-retries", 0, "")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+
+	typ, ok := proto.StringToNodeClient(c.nodeType)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("node type %s not found", c.nodeType))
+		return 1
+	}
+	restartPolicy, ok := proto.StringToRestartPolicy(c.restart)
+	if !ok {
+		c.UI.Error(fmt.Sprintf("restart policy %s not found", c.restart))
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	req := &proto.ValidatorMigrateRequest{
+		Name:          args[0],
+		NodeClient:    typ,
+		Beacon:        c.beaconName,
+		Repo:          c.repo,
+		Tag:           c.tag,
+		RestartPolicy: restartPolicy,
+		MaxRetries:    c.maxRetries,
+	}
+	resp, err := clt.ValidatorMigrate(context.Background(), req)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.output != "" {
+		if err := ioutil.WriteFile(c.output, resp.SlashingProtection, 0600); err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+	}
+
+	c.UI.Output(fmt.Sprintf("Validator '%s' migrated to '%s'", args[0], resp.Node.Name))
+	return 0
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// NodeRemoveCommand is the command to remove a node
+type NodeRemoveCommand struct {
+	*Meta
+}
+
+// Help implements the cli.Command interface
+func (c *NodeRemoveCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *NodeRemoveCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *NodeRemoveCommand) Run(args []string) int {
+	flags := c.FlagSet("node rm")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if _, err := clt.NodeRemove(context.Background(), &proto.NodeRemoveRequest{Name: args[0]}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(fmt.Sprintf("Node '%s' removed", args[0]))
+	return 0
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// NodeRestartCommand is the command to restart a node
+type NodeRestartCommand struct {
+	*Meta
+}
+
+// Help implements the cli.Command interface
+func (c *NodeRestartCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *NodeRestartCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *NodeRestartCommand) Run(args []string) int {
+	flags := c.FlagSet("node restart")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if _, err := clt.NodeRestart(context.Background(), &proto.NodeRestartRequest{Name: args[0]}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(fmt.Sprintf("Node '%s' restarted", args[0]))
+	return 0
+}
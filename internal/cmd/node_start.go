@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// NodeStartCommand is the command to start a stopped node
+type NodeStartCommand struct {
+	*Meta
+}
+
+// Help implements the cli.Command interface
+func (c *NodeStartCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *NodeStartCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *NodeStartCommand) Run(args []string) int {
+	flags := c.FlagSet("node start")
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("expected one argument")
+		return 1
+	}
+
+	clt, err := c.Conn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if _, err := clt.NodeStart(context.Background(), &proto.NodeStartRequest{Name: args[0]}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(fmt.Sprintf("Node '%s' started", args[0]))
+	return 0
+}
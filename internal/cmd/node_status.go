@@ -3,6 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/umbracle/viewpoint/internal/server/proto"
 )
@@ -48,15 +51,103 @@ func (c *NodeStatusCommand) Run(args []string) int {
 		return 1
 	}
 
-	c.UI.Output(formatNode(resp.Node))
+	c.UI.Output(formatNodeStatus(resp))
 	return 0
 }
 
+func formatNodeStatus(resp *proto.NodeStatusResponse) string {
+	base := formatNode(resp.Node)
+
+	if container := resp.Container; container != nil {
+		base += "\n\nContainer\n"
+		base += formatKV([]string{
+			fmt.Sprintf("ID|%s", container.Id),
+			fmt.Sprintf("Image|%s", container.Image),
+			fmt.Sprintf("State|%s", container.State),
+			fmt.Sprintf("Started At|%s", time.UnixMilli(container.StartedAt).Format(time.RFC3339)),
+			fmt.Sprintf("Uptime|%s", time.Duration(container.Uptime)*time.Second),
+		})
+		if len(container.Mounts) != 0 {
+			base += "\n\nMounts\n"
+			base += formatSortedMap("Path|Host Path", container.Mounts)
+		}
+		if len(container.Ports) != 0 {
+			base += "\n\nPorts\n"
+			base += formatSortedMap("Name|Address", container.Ports)
+		}
+	}
+
+	if beacon := resp.Beacon; beacon != nil {
+		base += "\n\nBeacon\n"
+		if beacon.Error != "" {
+			base += fmt.Sprintf("failed to query the beacon API: %s\n", beacon.Error)
+		}
+		base += formatKV([]string{
+			fmt.Sprintf("Version|%s", beacon.Version),
+			fmt.Sprintf("Head Slot|%d", beacon.HeadSlot),
+			fmt.Sprintf("Justified Epoch|%d", beacon.JustifiedEpoch),
+			fmt.Sprintf("Finalized Epoch|%d", beacon.FinalizedEpoch),
+			fmt.Sprintf("Syncing|%v", beacon.IsSyncing),
+			fmt.Sprintf("Sync Distance|%d", beacon.SyncDistance),
+			fmt.Sprintf("Peers|%d", beacon.Peers),
+		})
+	}
+
+	if validator := resp.Validator; validator != nil {
+		base += "\n\nValidator\n"
+		base += formatKV([]string{
+			fmt.Sprintf("Tranche|%d", validator.Tranche),
+			fmt.Sprintf("Num accounts|%d", len(validator.PubKeys)),
+		})
+		base += "\n\nPublic Keys\n"
+		base += strings.Join(validator.PubKeys, "\n")
+	}
+	return base
+}
+
+func formatSortedMap(header string, m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := []string{header}
+	for _, k := range keys {
+		rows = append(rows, fmt.Sprintf("%s|%s", k, m[k]))
+	}
+	return formatList(rows)
+}
+
+// nodeClient returns the client of the node, the execution nodes
+// have their own set of clients
+func nodeClient(node *proto.Node) string {
+	if node.Type == proto.NodeType_Execution {
+		return node.ExecutionClient.String()
+	}
+	if node.Type == proto.NodeType_Signer {
+		// the remote signers are not consensus clients
+		return node.Labels[proto.NodeClientLabel]
+	}
+	return node.Client.String()
+}
+
 func formatNode(node *proto.Node) string {
 	base := formatKV([]string{
 		fmt.Sprintf("Name|%s", node.Name),
 		fmt.Sprintf("Type|%s", node.Type.String()),
-		fmt.Sprintf("Client|%s", node.Client.String()),
+		fmt.Sprintf("Client|%s", nodeClient(node)),
+		fmt.Sprintf("State|%s", node.State.String()),
+		fmt.Sprintf("Restarts|%d", node.Restarts),
 	})
+	if exit := node.LastExit; exit != nil {
+		base += "\n\nLast Exit\n"
+		base += formatKV([]string{
+			fmt.Sprintf("Time|%s", time.UnixMilli(exit.Time).Format(time.RFC3339)),
+			fmt.Sprintf("Exit Code|%d", exit.ExitCode),
+			fmt.Sprintf("Error|%s", exit.Error),
+			fmt.Sprintf("Logs|%s", exit.Logs),
+		})
+	}
 	return base
 }
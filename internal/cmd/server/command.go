@@ -3,14 +3,20 @@ package server
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/mitchellh/cli"
+	"github.com/umbracle/viewpoint/internal/docker"
+	"github.com/umbracle/viewpoint/internal/process"
 	"github.com/umbracle/viewpoint/internal/server"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
 )
 
 // Command is the command that starts the agent
@@ -18,6 +24,15 @@ type Command struct {
 	UI       cli.Ui
 	client   *server.Server
 	logLevel string
+
+	runtime  string
+	binaries mapFlag
+	resume   string
+
+	grpcAddr      string
+	grpcTLS       bool
+	grpcToken     string
+	grpcTokenFile string
 }
 
 // Help implements the cli.Command interface
@@ -38,11 +53,24 @@ func (c *Command) Run(args []string) int {
 		return 1
 	}
 
-	logger := hclog.New(&hclog.LoggerOptions{
-		Name:  "viewpoint",
-		Level: hclog.LevelFromString(c.logLevel),
-	})
-	client, err := server.NewServer(logger, config)
+	logger := c.buildLogger()
+	runtime, err := c.buildRuntime(logger)
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to start %s runtime: %v", c.runtime, err))
+		return 1
+	}
+
+	var client *server.Server
+	if c.resume != "" {
+		resume := &server.ResumeConfig{
+			Name:      c.resume,
+			DataDir:   config.DataDir,
+			GrpcToken: config.GrpcToken,
+		}
+		client, err = server.ResumeServer(logger, resume, runtime)
+	} else {
+		client, err = server.NewServer(logger, config, runtime)
+	}
 	if err != nil {
 		c.UI.Output(fmt.Sprintf("failed to start server: %v", err))
 		return 1
@@ -51,6 +79,13 @@ func (c *Command) Run(args []string) int {
 	return c.handleSignals()
 }
 
+func (c *Command) buildLogger() hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:  "viewpoint",
+		Level: hclog.LevelFromString(c.logLevel),
+	})
+}
+
 func (c *Command) handleSignals() int {
 	signalCh := make(chan os.Signal, 4)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
@@ -74,33 +109,108 @@ func (c *Command) handleSignals() int {
 	}
 }
 
+// resumeFlags are the flags that can be used with --resume. The rest
+// of the settings of the network are read from its state.
+var resumeFlags = map[string]bool{
+	"resume":          true,
+	"data-dir":        true,
+	"runtime":         true,
+	"binary":          true,
+	"grpc-token":      true,
+	"grpc-token-file": true,
+}
+
 func (c *Command) readConfig(args []string) (*server.Config, error) {
-	var name, genesisTime string
+	var name, dataDir, genesisTime, preset, mnemonic, seed string
+	var genesisKeys, genesisKeysPassword string
 	var minGenesisValidatorCount, numGenesisValidators, numTranches uint64
-	var altair int
+	var altair, bellatrix int
 
 	flags := flag.NewFlagSet("server", flag.ContinueOnError)
 	flags.Usage = func() { c.UI.Error(c.Help()) }
 
 	flags.StringVar(&name, "name", "test", "")
+	flags.StringVar(&dataDir, "data-dir", "", "")
 	flags.Uint64Var(&minGenesisValidatorCount, "min-genesis-validator-count", 10, "")
 	flags.Uint64Var(&numGenesisValidators, "num-genesis-validators", 10, "")
 	flags.StringVar(&genesisTime, "genesis-time", "1m", "")
+	flags.StringVar(&preset, "preset", "mainnet", "")
+	flags.StringVar(&mnemonic, "mnemonic", "", "")
+	flags.StringVar(&seed, "seed", "", "")
+	flags.StringVar(&genesisKeys, "genesis-keys", "", "")
+	flags.StringVar(&genesisKeysPassword, "genesis-keys-password", "", "")
 	flags.Uint64Var(&numTranches, "num-tranches", 1, "")
 	flags.IntVar(&altair, "altair", -1, "")
+	flags.IntVar(&bellatrix, "bellatrix", -1, "")
+	flags.StringVar(&c.resume, "resume", "", "")
+	c.runtimeFlags(flags)
+	c.grpcFlags(flags)
+
+	config := server.DefaultConfig()
+	specFlags(flags, config.Spec)
 
 	if err := flags.Parse(args); err != nil {
 		return nil, err
 	}
+	config.DataDir = dataDir
+
+	if c.resume != "" {
+		var err error
+		flags.Visit(func(f *flag.Flag) {
+			if !resumeFlags[f.Name] && err == nil {
+				err = fmt.Errorf("--%s cannot be set with --resume", f.Name)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.applyGrpcFlags(config); err != nil {
+		return nil, err
+	}
+	if c.resume != "" {
+		// the rest of the config is read from the state of the network
+		return config, nil
+	}
+
+	// the values of the preset do not override the ones set with flags
+	explicit := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	slotsPerEpoch, votingPeriod := config.Spec.SlotsPerEpoch, config.Spec.EpochsPerEth1VotingPeriod
+	if err := config.Spec.ApplyPreset(preset); err != nil {
+		return nil, err
+	}
+	if explicit["slots-per-epoch"] {
+		config.Spec.SlotsPerEpoch = slotsPerEpoch
+	}
+	if explicit["epochs-per-eth1-voting-period"] {
+		config.Spec.EpochsPerEth1VotingPeriod = votingPeriod
+	}
 
-	config := server.DefaultConfig()
 	config.Name = name
+	config.Mnemonic = mnemonic
+	config.Seed = seed
+	if genesisKeys != "" {
+		keys, err := proto.ReadKeys(genesisKeys, genesisKeysPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read genesis keys: %v", err)
+		}
+		for _, key := range keys {
+			config.GenesisAccounts = append(config.GenesisAccounts, proto.NewAccountFromKey(key))
+		}
+	}
 	config.NumGenesisValidators = numGenesisValidators
 	config.Spec.MinGenesisValidatorCount = int(minGenesisValidatorCount)
 	config.NumTranches = numTranches
 	if altair >= 0 {
 		config.Spec.Altair = &altair
 	}
+	if bellatrix >= 0 {
+		config.Spec.Bellatrix = &bellatrix
+	}
 
 	config.Spec.MinGenesisTime = int(time.Now().Unix())
 	if genesisTime != "" {
@@ -110,5 +220,115 @@ func (c *Command) readConfig(args []string) (*server.Config, error) {
 		}
 		config.Spec.MinGenesisTime += int(duration.Seconds())
 	}
+	if err := config.Spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spec: %v", err)
+	}
+	if _, err := config.KeySeed(); err != nil {
+		return nil, err
+	}
 	return config, nil
 }
+
+// specFlags sets the flags to override the values of the Eth2 spec
+func specFlags(flags *flag.FlagSet, spec *server.Eth2Spec) {
+	flags.IntVar(&spec.GenesisDelay, "genesis-delay", spec.GenesisDelay, "")
+	flags.IntVar(&spec.SlotsPerEpoch, "slots-per-epoch", spec.SlotsPerEpoch, "")
+	flags.IntVar(&spec.SecondsPerSlot, "seconds-per-slot", spec.SecondsPerSlot, "")
+	flags.IntVar(&spec.SecondsPerEth1Block, "seconds-per-eth1-block", spec.SecondsPerEth1Block, "")
+	flags.IntVar(&spec.EthFollowDistance, "eth1-follow-distance", spec.EthFollowDistance, "")
+	flags.IntVar(&spec.EpochsPerEth1VotingPeriod, "epochs-per-eth1-voting-period", spec.EpochsPerEth1VotingPeriod, "")
+	flags.IntVar(&spec.ShardCommitteePeriod, "shard-committee-period", spec.ShardCommitteePeriod, "")
+	flags.IntVar(&spec.MinValidatorWithdrawabilityDelay, "min-validator-withdrawability-delay", spec.MinValidatorWithdrawabilityDelay, "")
+	flags.Uint64Var(&spec.EjectionBalance, "ejection-balance", spec.EjectionBalance, "")
+	flags.IntVar(&spec.MinPerEpochChurnLimit, "min-per-epoch-churn-limit", spec.MinPerEpochChurnLimit, "")
+	flags.IntVar(&spec.ChurnLimitQuotient, "churn-limit-quotient", spec.ChurnLimitQuotient, "")
+	flags.IntVar(&spec.InactivityScoreBias, "inactivity-score-bias", spec.InactivityScoreBias, "")
+	flags.IntVar(&spec.InactivityScoreRecoveryRate, "inactivity-score-recovery-rate", spec.InactivityScoreRecoveryRate, "")
+	flags.IntVar(&spec.ProposerScoreBoost, "proposer-score-boost", spec.ProposerScoreBoost, "")
+	flags.Var(&spec.GenesisForkVersion, "genesis-fork-version", "")
+	flags.Var(&spec.AltairForkVersion, "altair-fork-version", "")
+	flags.Var(&spec.BellatrixForkVersion, "bellatrix-fork-version", "")
+}
+
+// runtimeFlags sets the flags to select the runtime of the nodes
+func (c *Command) runtimeFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.runtime, "runtime", "docker", "")
+	flags.Var(&c.binaries, "binary", "")
+}
+
+// grpcFlags sets the flags of the grpc server
+func (c *Command) grpcFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.grpcAddr, "grpc-addr", "localhost:5555", "")
+	flags.BoolVar(&c.grpcTLS, "grpc-tls", false, "")
+	flags.StringVar(&c.grpcToken, "grpc-token", "", "")
+	flags.StringVar(&c.grpcTokenFile, "grpc-token-file", "", "")
+}
+
+func (c *Command) applyGrpcFlags(config *server.Config) error {
+	config.GrpcAddr = c.grpcAddr
+	config.GrpcTLS = c.grpcTLS
+	config.GrpcToken = c.grpcToken
+
+	if c.grpcTokenFile != "" {
+		if c.grpcToken != "" {
+			return fmt.Errorf("--grpc-token and --grpc-token-file cannot be set at the same time")
+		}
+		data, err := ioutil.ReadFile(c.grpcTokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read token file: %v", err)
+		}
+		config.GrpcToken = strings.TrimSpace(string(data))
+	}
+	return nil
+}
+
+func (c *Command) buildRuntime(logger hclog.Logger) (spec.Runtime, error) {
+	switch c.runtime {
+	case "docker":
+		if len(c.binaries) != 0 {
+			return nil, fmt.Errorf("--binary is only valid with the process runtime")
+		}
+		d, err := docker.NewDocker()
+		if err != nil {
+			return nil, err
+		}
+		d.SetLogger(logger.Named("docker"))
+		return d, nil
+
+	case "process":
+		p := process.NewProcess()
+		p.SetLogger(logger.Named("process"))
+		for repository, path := range c.binaries {
+			if err := p.SetBinary(repository, path); err != nil {
+				return nil, err
+			}
+		}
+		return p, nil
+
+	default:
+		return nil, fmt.Errorf("runtime '%s' not found", c.runtime)
+	}
+}
+
+// mapFlag is a flag of repeated key=value pairs
+type mapFlag map[string]string
+
+func (m *mapFlag) String() string {
+	res := []string{}
+	for k, v := range *m {
+		res = append(res, k+"="+v)
+	}
+	return strings.Join(res, ",")
+}
+
+func (m *mapFlag) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected key=value format but found '%s'", value)
+	}
+	if *m == nil {
+		*m = map[string]string{}
+	}
+	(*m)[parts[0]] = parts[1]
+	return nil
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/manifest"
+	"github.com/umbracle/viewpoint/internal/server"
+)
+
+// UpCommand is the command that starts the agent and deploys
+// the network described in a manifest
+type UpCommand struct {
+	Command
+
+	file string
+}
+
+// Help implements the cli.Command interface
+func (c *UpCommand) Help() string {
+	return ""
+}
+
+// Synopsis implements the cli.Command interface
+func (c *UpCommand) Synopsis() string {
+	return ""
+}
+
+// Run implements the cli.Command interface
+func (c *UpCommand) Run(args []string) int {
+	flags := flag.NewFlagSet("up", flag.ContinueOnError)
+	flags.Usage = func() { c.UI.Error(c.Help()) }
+
+	flags.StringVar(&c.file, "f", "network.yaml", "")
+	c.runtimeFlags(flags)
+	c.grpcFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	m, err := manifest.ReadFile(c.file)
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to read manifest: %v", err))
+		return 1
+	}
+	config, err := m.Config()
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to read config: %v", err))
+		return 1
+	}
+	if err := c.applyGrpcFlags(config); err != nil {
+		c.UI.Output(fmt.Sprintf("failed to read config: %v", err))
+		return 1
+	}
+
+	logger := c.buildLogger()
+	runtime, err := c.buildRuntime(logger)
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to start %s runtime: %v", c.runtime, err))
+		return 1
+	}
+
+	client, err := server.NewServer(logger, config, runtime)
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to start server: %v", err))
+		return 1
+	}
+	c.client = client
+
+	nodes, err := m.Apply(context.Background(), client)
+	if err != nil {
+		c.UI.Output(fmt.Sprintf("failed to apply manifest: %v", err))
+		client.Stop()
+		return 1
+	}
+	for _, node := range nodes {
+		logger.Info("node deployed", "name", node.Name, "type", node.Type.String(), "client", node.Client.String())
+	}
+	return c.handleSignals()
+}
@@ -0,0 +1,268 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// gethGenesis is the genesis of the execution chain in the geth format.
+// It is the format generated by Eth1Genesis and the one used by the
+// ExecutionConfig. The other clients convert it to their own format.
+type gethGenesis struct {
+	Config     *gethChainConfig        `json:"config"`
+	Alloc      map[string]*gethAccount `json:"alloc"`
+	Coinbase   string                  `json:"coinbase"`
+	Difficulty string                  `json:"difficulty"`
+	ExtraData  string                  `json:"extradata"`
+	GasLimit   string                  `json:"gasLimit"`
+	Nonce      string                  `json:"nonce"`
+	MixHash    string                  `json:"mixhash"`
+	ParentHash string                  `json:"parentHash"`
+	Timestamp  string                  `json:"timestamp"`
+}
+
+type gethChainConfig struct {
+	ChainID                 uint64      `json:"chainId"`
+	MergeForkBlock          uint64      `json:"mergeForkBlock"`
+	TerminalTotalDifficulty uint64      `json:"terminalTotalDifficulty"`
+	Clique                  *gethClique `json:"clique,omitempty"`
+}
+
+type gethClique struct {
+	Period uint64 `json:"period"`
+	Epoch  uint64 `json:"epoch"`
+}
+
+type gethAccount struct {
+	Balance string            `json:"balance"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// gethForks are the forks of the chain config, all of them enabled at genesis
+var gethForks = []string{
+	"homesteadBlock",
+	"eip150Block",
+	"eip155Block",
+	"eip158Block",
+	"byzantiumBlock",
+	"constantinopleBlock",
+	"petersburgBlock",
+	"istanbulBlock",
+	"berlinBlock",
+	"londonBlock",
+}
+
+// initialBaseFee is the base fee of the genesis block with London enabled
+const initialBaseFee = "0x3b9aca00"
+
+func parseGethGenesis(genesis string) (*gethGenesis, error) {
+	var g gethGenesis
+	var err error
+	if err = json.Unmarshal([]byte(genesis), &g); err != nil {
+		return nil, fmt.Errorf("failed to decode genesis: %v", err)
+	}
+	if g.Config == nil {
+		return nil, fmt.Errorf("genesis does not have a chain config")
+	}
+
+	// the other clients expect the quantities in hex
+	if g.Difficulty, err = toHexQuantity(g.Difficulty); err != nil {
+		return nil, err
+	}
+	for addr, account := range g.Alloc {
+		if account.Balance, err = toHexQuantity(account.Balance); err != nil {
+			return nil, fmt.Errorf("invalid balance for account %s: %v", addr, err)
+		}
+	}
+	return &g, nil
+}
+
+// toHexQuantity converts a decimal or hex number to hex
+func toHexQuantity(str string) (string, error) {
+	if strings.HasPrefix(str, "0x") {
+		return str, nil
+	}
+	num, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return "", fmt.Errorf("failed to parse number '%s'", str)
+	}
+	return "0x" + num.Text(16), nil
+}
+
+// toBesuGenesis converts a geth genesis to the genesis format of Besu
+func toBesuGenesis(genesis string) (string, error) {
+	g, err := parseGethGenesis(genesis)
+	if err != nil {
+		return "", err
+	}
+
+	config := map[string]interface{}{
+		"chainId":                 g.Config.ChainID,
+		"terminalTotalDifficulty": g.Config.TerminalTotalDifficulty,
+	}
+	for _, fork := range gethForks {
+		config[fork] = 0
+	}
+	if clique := g.Config.Clique; clique != nil {
+		config["clique"] = map[string]interface{}{
+			"blockperiodseconds": clique.Period,
+			"epochlength":        clique.Epoch,
+		}
+	} else {
+		config["ethash"] = map[string]interface{}{}
+	}
+
+	besu := map[string]interface{}{
+		"config":        config,
+		"alloc":         g.Alloc,
+		"coinbase":      g.Coinbase,
+		"difficulty":    g.Difficulty,
+		"extraData":     g.ExtraData,
+		"gasLimit":      g.GasLimit,
+		"nonce":         g.Nonce,
+		"mixHash":       g.MixHash,
+		"parentHash":    g.ParentHash,
+		"timestamp":     g.Timestamp,
+		"baseFeePerGas": initialBaseFee,
+	}
+	data, err := json.MarshalIndent(besu, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// nethermindTransitions are the eips of the forks up to London,
+// all of them enabled at genesis
+var nethermindTransitions = []string{
+	"eip140Transition",
+	"eip145Transition",
+	"eip150Transition",
+	"eip152Transition",
+	"eip155Transition",
+	"eip160Transition",
+	"eip161abcTransition",
+	"eip161dTransition",
+	"eip211Transition",
+	"eip214Transition",
+	"eip658Transition",
+	"eip1014Transition",
+	"eip1052Transition",
+	"eip1108Transition",
+	"eip1283Transition",
+	"eip1283DisableTransition",
+	"eip1344Transition",
+	"eip1559Transition",
+	"eip1884Transition",
+	"eip2028Transition",
+	"eip2200Transition",
+	"eip2565Transition",
+	"eip2929Transition",
+	"eip2930Transition",
+	"eip3198Transition",
+	"eip3529Transition",
+	"eip3541Transition",
+}
+
+// nethermindBuiltins are the precompiled contracts of the chain
+var nethermindBuiltins = map[string]interface{}{
+	"0x0000000000000000000000000000000000000001": builtin("ecrecover", "linear", map[string]uint64{"base": 3000, "word": 0}),
+	"0x0000000000000000000000000000000000000002": builtin("sha256", "linear", map[string]uint64{"base": 60, "word": 12}),
+	"0x0000000000000000000000000000000000000003": builtin("ripemd160", "linear", map[string]uint64{"base": 600, "word": 120}),
+	"0x0000000000000000000000000000000000000004": builtin("identity", "linear", map[string]uint64{"base": 15, "word": 3}),
+	"0x0000000000000000000000000000000000000005": builtin("modexp", "modexp", map[string]uint64{"divisor": 3}),
+	"0x0000000000000000000000000000000000000006": builtin("alt_bn128_add", "linear", map[string]uint64{"base": 150, "word": 0}),
+	"0x0000000000000000000000000000000000000007": builtin("alt_bn128_mul", "linear", map[string]uint64{"base": 6000, "word": 0}),
+	"0x0000000000000000000000000000000000000008": builtin("alt_bn128_pairing", "alt_bn128_pairing", map[string]uint64{"base": 45000, "pair": 34000}),
+	"0x0000000000000000000000000000000000000009": builtin("blake2_f", "blake2_f", map[string]uint64{"gas_per_round": 1}),
+}
+
+func builtin(name, pricing string, params map[string]uint64) map[string]interface{} {
+	return map[string]interface{}{
+		"balance": "0x1",
+		"builtin": map[string]interface{}{
+			"name":        name,
+			"activate_at": "0x0",
+			"pricing": map[string]interface{}{
+				pricing: params,
+			},
+		},
+	}
+}
+
+// toNethermindChainspec converts a geth genesis to the chainspec format of Nethermind
+func toNethermindChainspec(genesis string) (string, error) {
+	g, err := parseGethGenesis(genesis)
+	if err != nil {
+		return "", err
+	}
+
+	var engine map[string]interface{}
+	if clique := g.Config.Clique; clique != nil {
+		engine = map[string]interface{}{
+			"clique": map[string]interface{}{
+				"params": map[string]interface{}{
+					"period": clique.Period,
+					"epoch":  clique.Epoch,
+				},
+			},
+		}
+	} else {
+		engine = map[string]interface{}{
+			"Ethash": map[string]interface{}{},
+		}
+	}
+
+	params := map[string]interface{}{
+		"gasLimitBoundDivisor":    "0x400",
+		"accountStartNonce":       "0x0",
+		"maximumExtraDataSize":    "0xffff",
+		"minGasLimit":             "0x1388",
+		"maxCodeSize":             "0x6000",
+		"maxCodeSizeTransition":   "0x0",
+		"networkID":               fmt.Sprintf("0x%x", g.Config.ChainID),
+		"chainID":                 fmt.Sprintf("0x%x", g.Config.ChainID),
+		"terminalTotalDifficulty": fmt.Sprintf("0x%x", g.Config.TerminalTotalDifficulty),
+	}
+	for _, transition := range nethermindTransitions {
+		params[transition] = "0x0"
+	}
+
+	accounts := map[string]interface{}{}
+	for addr, builtin := range nethermindBuiltins {
+		accounts[addr] = builtin
+	}
+	for addr, account := range g.Alloc {
+		accounts[addr] = account
+	}
+
+	chainspec := map[string]interface{}{
+		"name":   "viewpoint",
+		"engine": engine,
+		"params": params,
+		"genesis": map[string]interface{}{
+			"seal": map[string]interface{}{
+				"ethereum": map[string]interface{}{
+					"nonce":   g.Nonce,
+					"mixHash": g.MixHash,
+				},
+			},
+			"difficulty":    g.Difficulty,
+			"author":        g.Coinbase,
+			"timestamp":     g.Timestamp,
+			"parentHash":    g.ParentHash,
+			"extraData":     g.ExtraData,
+			"gasLimit":      g.GasLimit,
+			"baseFeePerGas": initialBaseFee,
+		},
+		"accounts": accounts,
+	}
+	data, err := json.MarshalIndent(chainspec, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,136 @@
+package components
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+func buildDevGenesis(t *testing.T, postMerge bool) string {
+	genesis, _, err := NewDevGenesis()
+	require.NoError(t, err)
+
+	if postMerge {
+		genesis.PostMerge = true
+		genesis.TDD = 0
+	}
+	genesisRaw, err := genesis.Build()
+	require.NoError(t, err)
+	return genesisRaw
+}
+
+func TestChainspec_Besu(t *testing.T) {
+	genesis, _, err := NewDevGenesis()
+	require.NoError(t, err)
+
+	genesisRaw, err := genesis.Build()
+	require.NoError(t, err)
+
+	besuRaw, err := toBesuGenesis(genesisRaw)
+	require.NoError(t, err)
+
+	var besu struct {
+		Config     map[string]interface{}            `json:"config"`
+		Alloc      map[string]map[string]interface{} `json:"alloc"`
+		Difficulty string                            `json:"difficulty"`
+		BaseFee    string                            `json:"baseFeePerGas"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(besuRaw), &besu))
+
+	assert.Equal(t, "0x1", besu.Difficulty)
+	assert.Equal(t, initialBaseFee, besu.BaseFee)
+	assert.Equal(t, float64(0), besu.Config["londonBlock"])
+	assert.Contains(t, besu.Config, "clique")
+	assert.NotContains(t, besu.Config, "ethash")
+
+	// the balances are in hex
+	for _, account := range besu.Alloc {
+		assert.Regexp(t, "^0x[0-9a-f]+$", account["balance"])
+	}
+	assert.Len(t, besu.Alloc, len(genesis.Allocs))
+}
+
+func TestChainspec_Besu_PostMerge(t *testing.T) {
+	besuRaw, err := toBesuGenesis(buildDevGenesis(t, true))
+	require.NoError(t, err)
+
+	var besu struct {
+		Config map[string]interface{} `json:"config"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(besuRaw), &besu))
+
+	assert.NotContains(t, besu.Config, "clique")
+	assert.Contains(t, besu.Config, "ethash")
+	assert.Equal(t, float64(0), besu.Config["terminalTotalDifficulty"])
+}
+
+func TestChainspec_Nethermind(t *testing.T) {
+	chainspecRaw, err := toNethermindChainspec(buildDevGenesis(t, false))
+	require.NoError(t, err)
+
+	var chainspec struct {
+		Engine   map[string]interface{} `json:"engine"`
+		Params   map[string]interface{} `json:"params"`
+		Genesis  map[string]interface{} `json:"genesis"`
+		Accounts map[string]interface{} `json:"accounts"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(chainspecRaw), &chainspec))
+
+	assert.Contains(t, chainspec.Engine, "clique")
+	assert.Equal(t, "0x539", chainspec.Params["chainID"])
+	assert.Equal(t, "0x0", chainspec.Params["eip1559Transition"])
+	assert.Equal(t, "0x1", chainspec.Genesis["difficulty"])
+
+	// the precompiles are included with the allocs
+	assert.Contains(t, chainspec.Accounts, "0x0000000000000000000000000000000000000001")
+}
+
+func TestChainspec_Nethermind_PostMerge(t *testing.T) {
+	chainspecRaw, err := toNethermindChainspec(buildDevGenesis(t, true))
+	require.NoError(t, err)
+
+	var chainspec struct {
+		Engine map[string]interface{} `json:"engine"`
+		Params map[string]interface{} `json:"params"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(chainspecRaw), &chainspec))
+
+	assert.NotContains(t, chainspec.Engine, "clique")
+	assert.Equal(t, "0x0", chainspec.Params["terminalTotalDifficulty"])
+}
+
+func TestChainspec_InvalidGenesis(t *testing.T) {
+	_, err := toBesuGenesis("{}")
+	require.Error(t, err)
+
+	_, err = toNethermindChainspec("not json")
+	require.Error(t, err)
+}
+
+func TestExecution_Factories(t *testing.T) {
+	factories := map[proto.ExecutionClient]proto.CreateExecution{
+		proto.ExecutionClient_Geth:       NewGethExecution,
+		proto.ExecutionClient_Nethermind: NewNethermindExecution,
+		proto.ExecutionClient_Besu:       NewBesuExecution,
+		proto.ExecutionClient_Erigon:     NewErigonExecution,
+	}
+
+	genesis := buildDevGenesis(t, true)
+	for client, factory := range factories {
+		config := &proto.ExecutionConfig{
+			Bootnode:  "enode://bootnode",
+			Genesis:   genesis,
+			JwtSecret: []byte{0x1},
+		}
+		spec, err := factory(config)
+		require.NoError(t, err, client.String())
+
+		assert.True(t, spec.HasLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()))
+		assert.True(t, spec.HasLabel(proto.ExecutionClientLabel, client.String()))
+		assert.Equal(t, "0x01", string(spec.Files["/data/jwtsecret"]))
+		assert.NotNil(t, spec.Retry)
+	}
+}
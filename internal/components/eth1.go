@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/umbracle/ethgo"
@@ -41,6 +42,14 @@ func NewDevGenesis() (*Eth1Genesis, *wallet.Key, error) {
 	return genesis, key, nil
 }
 
+// NewGethExecution creates a new execution node with go-ethereum
+func NewGethExecution(config *proto.ExecutionConfig) (*spec.Spec, error) {
+	ss := NewEth1Server(config).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()).
+		WithLabel(proto.ExecutionClientLabel, proto.ExecutionClient_Geth.String())
+	return ss, nil
+}
+
 // NewEth1Server creates a new eth1 server with go-ethereum
 func NewEth1Server(config *proto.ExecutionConfig) *spec.Spec {
 	cmd := []string{
@@ -63,6 +72,15 @@ func NewEth1Server(config *proto.ExecutionConfig) *spec.Spec {
 		"--discovery.dns", "\"\"", // disable dns discovery
 		// "--verbosity", "4",
 	}
+	if len(config.JwtSecret) != 0 {
+		// expose the engine api to the beacon nodes
+		jwtCmd := []string{
+			"--authrpc.addr", "0.0.0.0",
+			"--authrpc.vhosts", `"*"`,
+			"--authrpc.jwtsecret", "/data/jwtsecret",
+		}
+		cmd = append(cmd, jwtCmd...)
+	}
 	if config.Bootnode != "" {
 		cmd = append(cmd, "--bootnodes", config.Bootnode)
 	}
@@ -94,6 +112,10 @@ func NewEth1Server(config *proto.ExecutionConfig) *spec.Spec {
 			return testHTTPEndpoint(n.GetAddr(proto.NodePortEth1Http))
 		})
 
+	if len(config.JwtSecret) != 0 {
+		ss.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+
 	if config.Key != nil {
 		keystore, err := toKeystoreV3(config.Key)
 		if err != nil {
@@ -116,30 +138,102 @@ func testHTTPEndpoint(endpoint string) error {
 	return nil
 }
 
+// encodeJwtSecret encodes the jwt secret in the hex format of the secret files
+func encodeJwtSecret(secret []byte) string {
+	return "0x" + hex.EncodeToString(secret)
+}
+
+// cliqueInTurnDifficulty is the difficulty of a block sealed by the in-turn
+// signer, which is always the case for a chain with a single signer
+const cliqueInTurnDifficulty = 2
+
 type Eth1Genesis struct {
 	MergeForkBlock uint64
 	TDD            uint64
 	Period         uint64
 	Allocs         map[ethgo.Address]string
+	Contracts      map[ethgo.Address]*GenesisContract
 	Validators     []ethgo.Address
 	Extra          string
+
+	// PostMerge starts the chain already merged (proof of stake) from the
+	// genesis block. Clique is disabled and the terminal total difficulty is 0.
+	PostMerge bool
 }
 
-func (e *Eth1Genesis) Build() (string, error) {
-	if len(e.Validators) == 0 {
-		return "", fmt.Errorf("no genesis validators")
+// GenesisContract is a contract deployed in the genesis of the chain
+type GenesisContract struct {
+	Code    []byte
+	Storage map[ethgo.Hash]ethgo.Hash
+}
+
+// TotalDifficultyAt returns the expected total difficulty of the clique chain
+// after it has been running for the given duration
+func (e *Eth1Genesis) TotalDifficultyAt(d time.Duration) uint64 {
+	if d < 0 {
+		d = 0
+	}
+	blocks := uint64(d / (time.Duration(e.Period) * time.Second))
+
+	// the genesis block has a difficulty of 1
+	return 1 + blocks*cliqueInTurnDifficulty
+}
+
+// AllocJSON returns the json encoding of the accounts in the genesis
+func (e *Eth1Genesis) AllocJSON() (string, error) {
+	type genesisAccount struct {
+		Balance string            `json:"balance"`
+		Code    string            `json:"code,omitempty"`
+		Storage map[string]string `json:"storage,omitempty"`
+	}
+
+	alloc := map[string]*genesisAccount{}
+	for addr, balance := range e.Allocs {
+		alloc[addr.String()] = &genesisAccount{
+			Balance: balance,
+		}
+	}
+	for addr, contract := range e.Contracts {
+		account := &genesisAccount{
+			Balance: "0",
+			Code:    "0x" + hex.EncodeToString(contract.Code),
+			Storage: map[string]string{},
+		}
+		for k, v := range contract.Storage {
+			account.Storage[k.String()] = v.String()
+		}
+		alloc[addr.String()] = account
+	}
+
+	data, err := json.Marshal(alloc)
+	if err != nil {
+		return "", err
 	}
+	return string(data), nil
+}
+
+func (e *Eth1Genesis) Build() (string, error) {
+	if e.PostMerge {
+		// the execution payload header of the genesis block is part of the
+		// beacon state, which does not allow the clique signers in the extra data
+		e.TDD = 0
+		e.Extra = "0x"
+	} else {
+		if len(e.Validators) == 0 {
+			return "", fmt.Errorf("no genesis validators")
+		}
 
-	// build the extra genesis
-	sort.Slice(e.Validators, func(i, j int) bool {
-		return bytes.Compare(e.Validators[i][:], e.Validators[j][:]) < 0
-	})
-	extra := make([]byte, 32)
-	for _, addr := range e.Validators {
-		extra = append(extra, addr.Bytes()...)
+		// build the extra genesis
+		sort.Slice(e.Validators, func(i, j int) bool {
+			return bytes.Compare(e.Validators[i][:], e.Validators[j][:]) < 0
+		})
+		extra := make([]byte, 32)
+		for _, addr := range e.Validators {
+			extra = append(extra, addr.Bytes()...)
+		}
+		extra = append(extra, make([]byte, 65)...)
+		e.Extra = "0x" + hex.EncodeToString(extra)
 	}
-	extra = append(extra, make([]byte, 65)...)
-	e.Extra = "0x" + hex.EncodeToString(extra)
 
 	tmpl, err := template.New("name").Parse(genesisTmpl)
 	if err != nil {
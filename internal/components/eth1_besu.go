@@ -0,0 +1,64 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// NewBesuExecution creates a new execution node with besu
+func NewBesuExecution(config *proto.ExecutionConfig) (*spec.Spec, error) {
+	if config.Key != nil {
+		return nil, fmt.Errorf("besu cannot seal blocks")
+	}
+	genesis, err := toBesuGenesis(config.Genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{
+		"--data-path", "/data/besu",
+		"--genesis-file", "/data/genesis.json",
+		"--network-id", "1337",
+		"--sync-mode", "FULL",
+		// json-rpc
+		"--rpc-http-enabled",
+		"--rpc-http-host", "0.0.0.0",
+		"--rpc-http-port", `{{ Port "eth1.http" }}`,
+		"--rpc-http-api", "ETH,NET,WEB3,ADMIN",
+		"--host-allowlist", "*",
+		// p2p
+		"--p2p-port", `{{ Port "eth1.p2p" }}`,
+	}
+	if config.Bootnode != "" {
+		cmd = append(cmd, "--bootnodes", config.Bootnode)
+	}
+	if len(config.JwtSecret) != 0 {
+		// engine api
+		cmd = append(cmd,
+			"--engine-rpc-enabled",
+			"--engine-rpc-port", `{{ Port "eth1.authrpc" }}`,
+			"--engine-host-allowlist", "*",
+			"--engine-jwt-secret", "/data/jwtsecret",
+		)
+	}
+
+	ss := &spec.Spec{}
+	ss.WithLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()).
+		WithLabel(proto.ExecutionClientLabel, proto.ExecutionClient_Besu.String()).
+		WithContainer("hyperledger/besu").
+		WithTag("22.7.7").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/genesis.json", genesis).
+		WithUser("0:0").
+		WithRetry(func(n spec.Node) error {
+			return testHTTPEndpoint(n.GetAddr(proto.NodePortEth1Http))
+		})
+
+	if len(config.JwtSecret) != 0 {
+		ss.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+	return ss, nil
+}
@@ -0,0 +1,69 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// NewErigonExecution creates a new execution node with erigon. Erigon
+// uses the same genesis format as go-ethereum.
+func NewErigonExecution(config *proto.ExecutionConfig) (*spec.Spec, error) {
+	if config.Key != nil {
+		return nil, fmt.Errorf("erigon cannot seal blocks")
+	}
+
+	cmd := []string{
+		// init with a custom genesis
+		"erigon",
+		"--datadir", "/data/erigon",
+		"init", "/data/genesis.json",
+		"&&",
+		// start the execution node
+		"erigon",
+		"--datadir", "/data/erigon",
+		"--networkid", "1337",
+		"--externalcl",
+		// json-rpc
+		"--http",
+		"--http.addr", "0.0.0.0",
+		"--http.port", `{{ Port "eth1.http" }}`,
+		"--http.api", "eth,net,web3,admin",
+		// p2p
+		"--port", `{{ Port "eth1.p2p" }}`,
+		"--nodiscover=false",
+	}
+	if config.Bootnode != "" {
+		cmd = append(cmd, "--bootnodes", config.Bootnode)
+	}
+	if len(config.JwtSecret) != 0 {
+		// engine api
+		cmd = append(cmd,
+			"--authrpc.addr", "0.0.0.0",
+			"--authrpc.port", `{{ Port "eth1.authrpc" }}`,
+			"--authrpc.vhosts", `"*"`,
+			"--authrpc.jwtsecret", "/data/jwtsecret",
+		)
+	}
+
+	ss := &spec.Spec{}
+	ss.WithLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()).
+		WithLabel(proto.ExecutionClientLabel, proto.ExecutionClient_Erigon.String()).
+		WithContainer("thorax/erigon").
+		WithTag("v2.27.0").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{strings.Join(cmd, " ")}).
+		WithMount("/data").
+		WithFile("/data/genesis.json", config.Genesis).
+		WithUser("0:0").
+		WithRetry(func(n spec.Node) error {
+			return testHTTPEndpoint(n.GetAddr(proto.NodePortEth1Http))
+		})
+
+	if len(config.JwtSecret) != 0 {
+		ss.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+	return ss, nil
+}
@@ -0,0 +1,62 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// NewNethermindExecution creates a new execution node with nethermind
+func NewNethermindExecution(config *proto.ExecutionConfig) (*spec.Spec, error) {
+	if config.Key != nil {
+		return nil, fmt.Errorf("nethermind cannot seal blocks")
+	}
+	chainspec, err := toNethermindChainspec(config.Genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{
+		"--config", "none",
+		"--datadir", "/data",
+		"--Init.ChainSpecPath", "/data/chainspec.json",
+		"--Init.IsMining", "false",
+		// json-rpc
+		"--JsonRpc.Enabled", "true",
+		"--JsonRpc.Host", "0.0.0.0",
+		"--JsonRpc.Port", `{{ Port "eth1.http" }}`,
+		"--JsonRpc.EnabledModules", "Eth,Net,Web3,Admin",
+		// p2p
+		"--Network.P2PPort", `{{ Port "eth1.p2p" }}`,
+		"--Network.DiscoveryPort", `{{ Port "eth1.p2p" }}`,
+	}
+	if config.Bootnode != "" {
+		cmd = append(cmd, "--Discovery.Bootnodes", config.Bootnode)
+	}
+	if len(config.JwtSecret) != 0 {
+		// engine api
+		cmd = append(cmd,
+			"--JsonRpc.EngineHost", "0.0.0.0",
+			"--JsonRpc.EnginePort", `{{ Port "eth1.authrpc" }}`,
+			"--JsonRpc.JwtSecretFile", "/data/jwtsecret",
+		)
+	}
+
+	ss := &spec.Spec{}
+	ss.WithLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()).
+		WithLabel(proto.ExecutionClientLabel, proto.ExecutionClient_Nethermind.String()).
+		WithContainer("nethermind/nethermind").
+		WithTag("1.14.3").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/chainspec.json", chainspec).
+		WithRetry(func(n spec.Node) error {
+			return testHTTPEndpoint(n.GetAddr(proto.NodePortEth1Http))
+		})
+
+	if len(config.JwtSecret) != 0 {
+		ss.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+	return ss, nil
+}
@@ -1,10 +1,13 @@
 package components
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/jsonrpc"
 	"github.com/umbracle/viewpoint/internal/docker"
@@ -72,3 +75,48 @@ func TestEth1_BuildGenesis(t *testing.T) {
 	_, err := e.Build()
 	assert.NoError(t, err)
 }
+
+func TestEth1_BuildGenesis_PostMerge(t *testing.T) {
+	e := &Eth1Genesis{
+		TDD: 100,
+		Allocs: map[ethgo.Address]string{
+			{0x1}: "10000000000",
+		},
+		Contracts: map[ethgo.Address]*GenesisContract{
+			{0x2}: {
+				Code: []byte{0x60, 0x80},
+				Storage: map[ethgo.Hash]ethgo.Hash{
+					{0x1}: {0x2},
+				},
+			},
+		},
+		PostMerge: true,
+	}
+	raw, err := e.Build()
+	require.NoError(t, err)
+
+	var genesis struct {
+		Config    map[string]interface{}
+		Alloc     map[string]map[string]interface{}
+		ExtraData string
+	}
+	require.NoError(t, json.Unmarshal([]byte(raw), &genesis))
+
+	// the chain is merged in genesis without clique
+	assert.Equal(t, float64(0), genesis.Config["terminalTotalDifficulty"])
+	assert.NotContains(t, genesis.Config, "clique")
+	assert.Equal(t, "0x", genesis.ExtraData)
+
+	contract := genesis.Alloc[ethgo.Address{0x2}.String()]
+	assert.Equal(t, "0x6080", contract["code"])
+	assert.Len(t, contract["storage"], 1)
+}
+
+func TestEth1_TotalDifficultyAt(t *testing.T) {
+	e := &Eth1Genesis{
+		Period: 2,
+	}
+	assert.Equal(t, uint64(1), e.TotalDifficultyAt(-time.Second))
+	assert.Equal(t, uint64(1), e.TotalDifficultyAt(time.Second))
+	assert.Equal(t, uint64(21), e.TotalDifficultyAt(20*time.Second))
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"encoding/hex"
+	"strings"
 
 	"github.com/umbracle/go-eth-consensus/bls"
 	"github.com/umbracle/viewpoint/internal/server/proto"
@@ -29,6 +30,13 @@ func NewLighthouseBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 		"--disable-packet-filter",
 		"--enable-private-discovery",
 	}
+	if config.Engine != "" {
+		// engine api of the execution node
+		cmd = append(cmd,
+			"--execution-endpoints", config.Engine,
+			"--execution-jwt", "/data/jwtsecret",
+		)
+	}
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Lighthouse.String()).
 		WithLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()).
@@ -43,6 +51,9 @@ func NewLighthouseBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 	if config.Bootnode != "" {
 		spec.WithFile("/data/boot_enr.yaml", "- "+config.Bootnode+"\n")
 	}
+	if config.Engine != "" {
+		spec.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
 	return spec, nil
 }
 
@@ -55,6 +66,9 @@ func NewLighthouseValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 		"--testnet-dir", "/data",
 		"--init-slashing-protection",
 	}
+	if config.FeeRecipient != "" {
+		cmd = append(cmd, "--suggested-fee-recipient", config.FeeRecipient)
+	}
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Lighthouse.String()).
 		WithLabel(proto.NodeTypeLabel, proto.NodeType_Validator.String()).
@@ -65,6 +79,12 @@ func NewLighthouseValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 		WithFile("/data/config.yaml", config.Spec).
 		WithFile("/data/deploy_block.txt", "0")
 
+	if config.RemoteSigner != "" {
+		// the validators are defined explicitly to sign with the remote signer
+		spec.WithFile("/data/node/validators/validator_definitions.yml", lighthouseRemoteDefinitions(config.Accounts, config.RemoteSigner))
+		return spec, nil
+	}
+
 	// append validators
 	for _, acct := range config.Accounts {
 		pub := acct.Bls.PubKey()
@@ -80,3 +100,49 @@ func NewLighthouseValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 	}
 	return spec, nil
 }
+
+// lighthouseRemoteDefinitions returns the validator definitions of
+// the accounts with the web3signer remote signer
+func lighthouseRemoteDefinitions(accounts []*proto.Account, signer string) string {
+	var definitions strings.Builder
+	for _, acct := range accounts {
+		pub := acct.Bls.PubKey()
+
+		definitions.WriteString("- enabled: true\n")
+		definitions.WriteString("  voting_public_key: \"0x" + hex.EncodeToString(pub[:]) + "\"\n")
+		definitions.WriteString("  type: web3signer\n")
+		definitions.WriteString("  url: \"" + signer + "\"\n")
+	}
+	return definitions.String()
+}
+
+// NewLighthouseSlashingExport exports the slashing protection database of the validator
+func NewLighthouseSlashingExport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newLighthouseSlashingJob(config, "export"), nil
+}
+
+// NewLighthouseSlashingImport imports the interchange file in the slashing protection database
+func NewLighthouseSlashingImport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newLighthouseSlashingJob(config, "import"), nil
+}
+
+func newLighthouseSlashingJob(config *proto.ValidatorConfig, action string) *spec.Spec {
+	cmd := []string{
+		"lighthouse",
+		"--testnet-dir", "/data",
+		// the database is in the validators folder of the data dir
+		"--datadir", "/data/node",
+		"account", "validator", "slashing-protection", action, SlashingProtectionFile,
+	}
+	spec := &spec.Spec{}
+	spec.WithContainer("sigp/lighthouse").
+		WithTag("v2.2.1").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec).
+		WithFile("/data/deploy_block.txt", "0").
+		// the genesis validators root is read from the genesis state
+		WithFile("/data/genesis.ssz", config.GenesisSSZ)
+
+	return spec
+}
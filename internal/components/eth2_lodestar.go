@@ -0,0 +1,144 @@
+package components
+
+import (
+	"encoding/hex"
+
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// NewLodestarBeacon creates a new lodestar server
+func NewLodestarBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
+	cmd := []string{
+		"beacon",
+		"--logLevel", "debug",
+		// config
+		"--paramsFile", "/data/config.yaml",
+		"--genesisStateFile", "/data/genesis.ssz",
+		"--dataDir", "/data/beacon",
+		// eth1x
+		"--eth1",
+		"--eth1.providerUrls", config.Eth1,
+		// rest api
+		"--rest",
+		"--rest.address", "0.0.0.0",
+		"--rest.port", `{{ Port "eth2.http" }}`,
+		// p2p
+		"--port", `{{ Port "eth2.p2p" }}`,
+		"--enr.ip", "127.0.0.1",
+		"--enr.tcp", `{{ Port "eth2.p2p" }}`,
+		"--enr.udp", `{{ Port "eth2.p2p" }}`,
+		"--subscribeAllSubnets",
+		// required to allow discovery in private networks
+		"--network.connectToDiscv5Bootnodes",
+	}
+	if config.Bootnode != "" {
+		cmd = append(cmd, "--bootnodes", config.Bootnode)
+	}
+	if config.Engine != "" {
+		// engine api of the execution node
+		cmd = append(cmd,
+			"--execution.urls", config.Engine,
+			"--jwt-secret", "/data/jwtsecret",
+		)
+	}
+	spec := &spec.Spec{}
+	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Lodestar.String()).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()).
+		WithContainer("chainsafe/lodestar").
+		WithTag("v1.2.1").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec).
+		WithFile("/data/genesis.ssz", config.GenesisSSZ)
+
+	if config.Engine != "" {
+		spec.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+	withLodestarPreset(spec, config.Preset)
+	return spec, nil
+}
+
+// NewLodestarValidator creates a new lodestar validator
+func NewLodestarValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	cmd := []string{
+		"validator",
+		"--logLevel", "debug",
+		// config
+		"--paramsFile", "/data/config.yaml",
+		"--dataDir", "/data/node",
+		// beacon api
+		"--beaconNodes", config.Beacon.GetAddr(proto.NodePortHttp),
+		// keys
+		"--keystoresDir", "/data/keystores",
+		"--secretsDir", "/data/secrets",
+	}
+	if config.FeeRecipient != "" {
+		cmd = append(cmd, "--suggestedFeeRecipient", config.FeeRecipient)
+	}
+	spec := &spec.Spec{}
+	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Lodestar.String()).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Validator.String()).
+		WithContainer("chainsafe/lodestar").
+		WithTag("v1.2.1").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec)
+
+	withLodestarPreset(spec, config.Preset)
+
+	// lodestar uses the same layout as the lighthouse validator directory
+	for _, acct := range config.Accounts {
+		pub := acct.Bls.PubKey()
+		pubStr := "0x" + hex.EncodeToString(pub[:])
+
+		keystore, err := bls.ToKeystore(acct.Bls, defWalletPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		spec.WithFile("/data/keystores/"+pubStr+"/voting-keystore.json", keystore).
+			WithFile("/data/secrets/"+pubStr, defWalletPassword)
+	}
+	return spec, nil
+}
+
+// withLodestarPreset sets the preset of lodestar. The preset is loaded
+// from the environment before the params file is read.
+func withLodestarPreset(s *spec.Spec, preset string) {
+	if preset != "" && preset != "mainnet" {
+		s.WithEnv("LODESTAR_PRESET", preset)
+	}
+}
+
+// NewLodestarSlashingExport exports the slashing protection database of the validator
+func NewLodestarSlashingExport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newLodestarSlashingJob(config, "export"), nil
+}
+
+// NewLodestarSlashingImport imports the interchange file in the slashing protection database
+func NewLodestarSlashingImport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newLodestarSlashingJob(config, "import"), nil
+}
+
+func newLodestarSlashingJob(config *proto.ValidatorConfig, action string) *spec.Spec {
+	cmd := []string{
+		"validator", "slashing-protection", action,
+		"--file", SlashingProtectionFile,
+		// config
+		"--paramsFile", "/data/config.yaml",
+		"--dataDir", "/data/node",
+		// the genesis validators root is queried from the beacon node
+		"--beaconNodes", config.Beacon.GetAddr(proto.NodePortHttp),
+	}
+	spec := &spec.Spec{}
+	spec.WithContainer("chainsafe/lodestar").
+		WithTag("v1.2.1").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec)
+
+	withLodestarPreset(spec, config.Preset)
+	return spec
+}
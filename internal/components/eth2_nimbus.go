@@ -0,0 +1,132 @@
+package components
+
+import (
+	"encoding/hex"
+
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// nimbusBuildDir is the directory of the nimbus binaries in the container
+const nimbusBuildDir = "/home/user/nimbus-eth2/build/"
+
+// NewNimbusBeacon creates a new nimbus server
+func NewNimbusBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
+	eth1 := config.Eth1
+	if config.Engine != "" {
+		eth1 = config.Engine
+	}
+	cmd := []string{
+		"--non-interactive",
+		"--log-level", "DEBUG",
+		// the network directory includes the config and the genesis state
+		"--network", "/data",
+		"--data-dir", "/data/beacon",
+		// eth1x
+		"--web3-url", eth1,
+		// rest api
+		"--rest",
+		"--rest-address", "0.0.0.0",
+		"--rest-port", `{{ Port "eth2.http" }}`,
+		// p2p
+		"--tcp-port", `{{ Port "eth2.p2p" }}`,
+		"--udp-port", `{{ Port "eth2.p2p" }}`,
+		"--nat", "extip:127.0.0.1",
+		"--subscribe-all-subnets",
+		"--doppelganger-detection", "off",
+	}
+	if config.Bootnode != "" {
+		cmd = append(cmd, "--bootstrap-node", config.Bootnode)
+	}
+	if config.Engine != "" {
+		// the engine api replaces the eth1 endpoint
+		cmd = append(cmd, "--jwt-secret", "/data/jwtsecret")
+	}
+	spec := &spec.Spec{}
+	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Nimbus.String()).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()).
+		WithContainer("statusim/nimbus-eth2").
+		WithTag("multiarch-v22.5.1").
+		WithEntrypoint([]string{nimbusBuildDir + "nimbus_beacon_node"}).
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec).
+		WithFile("/data/genesis.ssz", config.GenesisSSZ).
+		WithFile("/data/deposit_contract_block.txt", "0").
+		WithUser("0:0")
+
+	if config.Engine != "" {
+		spec.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
+	return spec, nil
+}
+
+// NewNimbusValidator creates a new nimbus validator
+func NewNimbusValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	cmd := []string{
+		"--non-interactive",
+		"--log-level", "DEBUG",
+		"--data-dir", "/data/node",
+		// beacon api
+		"--beacon-node", config.Beacon.GetAddr(proto.NodePortHttp),
+		// keys
+		"--validators-dir", "/data/node/validators",
+		"--secrets-dir", "/data/node/secrets",
+		"--doppelganger-detection", "off",
+	}
+	if config.FeeRecipient != "" {
+		cmd = append(cmd, "--suggested-fee-recipient", config.FeeRecipient)
+	}
+	spec := &spec.Spec{}
+	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Nimbus.String()).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Validator.String()).
+		WithContainer("statusim/nimbus-eth2").
+		WithTag("multiarch-v22.5.1").
+		WithEntrypoint([]string{nimbusBuildDir + "nimbus_validator_client"}).
+		WithCmd(cmd).
+		WithMount("/data").
+		WithUser("0:0")
+
+	// nimbus expects a 'keystore.json' file in the directory of each validator
+	for _, acct := range config.Accounts {
+		pub := acct.Bls.PubKey()
+		pubStr := "0x" + hex.EncodeToString(pub[:])
+
+		keystore, err := bls.ToKeystore(acct.Bls, defWalletPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		spec.WithFile("/data/node/validators/"+pubStr+"/keystore.json", keystore).
+			WithFile("/data/node/secrets/"+pubStr, defWalletPassword)
+	}
+	return spec, nil
+}
+
+// NewNimbusSlashingExport exports the slashing protection database of the validator
+func NewNimbusSlashingExport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newNimbusSlashingJob("export"), nil
+}
+
+// NewNimbusSlashingImport imports the interchange file in the slashing protection database
+func NewNimbusSlashingImport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newNimbusSlashingJob("import"), nil
+}
+
+func newNimbusSlashingJob(action string) *spec.Spec {
+	cmd := []string{
+		// the database is in the validators folder of the data dir
+		"--data-dir", "/data/node",
+		"slashingdb", action, SlashingProtectionFile,
+	}
+	spec := &spec.Spec{}
+	spec.WithContainer("statusim/nimbus-eth2").
+		WithTag("multiarch-v22.5.1").
+		WithEntrypoint([]string{nimbusBuildDir + "nimbus_beacon_node"}).
+		WithCmd(cmd).
+		WithMount("/data").
+		WithUser("0:0")
+
+	return spec
+}
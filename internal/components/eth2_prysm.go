@@ -2,6 +2,7 @@ package components
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"strings"
 
 	"github.com/umbracle/ethgo/keystore"
@@ -12,10 +13,14 @@ import (
 
 // NewPrysmBeacon creates a new prysm server
 func NewPrysmBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
+	eth1 := config.Eth1
+	if config.Engine != "" {
+		eth1 = config.Engine
+	}
 	cmd := []string{
 		"--verbosity", "debug",
 		// eth1x
-		"--http-web3provider", config.Eth1,
+		"--http-web3provider", eth1,
 		"--contract-deployment-block", "0",
 		// these sync fields have to be disabled for single node
 		"--min-sync-peers", "1",
@@ -42,6 +47,10 @@ func NewPrysmBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 	if config.Bootnode != "" {
 		cmd = append(cmd, "--bootstrap-node", config.Bootnode)
 	}
+	if config.Engine != "" {
+		// the engine api replaces the eth1 endpoint
+		cmd = append(cmd, "--jwt-secret", "/data/jwtsecret")
+	}
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Prysm.String()).
 		WithLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()).
@@ -52,33 +61,44 @@ func NewPrysmBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 		WithFile("/data/config.yaml", config.Spec).
 		WithFile("/data/genesis.ssz", config.GenesisSSZ)
 
+	if config.Engine != "" {
+		spec.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
 	return spec, nil
 }
 
 const defWalletPassword = "qwerty"
 
-func NewPrysmValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
-	store := &accountStore{}
-	for _, acct := range config.Accounts {
-		store.AddKey(acct.Bls)
-	}
-
-	keystore, err := store.ToKeystore(defWalletPassword)
-	if err != nil {
-		return nil, err
-	}
+// prysmRemoteSignerTag is the first release of prysm with web3signer support
+const prysmRemoteSignerTag = "v2.1.0"
 
+func NewPrysmValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 	cmd := []string{
 		"--verbosity", "debug",
 		// accept terms and conditions
 		"--accept-terms-of-use",
-		// wallet dir and password
-		"--wallet-dir", "/data",
-		"--wallet-password-file", "/data/wallet-password.txt",
 		// beacon node reference of the GRPC endpoint
 		"--beacon-rpc-provider", strings.TrimPrefix(config.Beacon.GetAddr(proto.NodePortPrysmGrpc), "http://"),
 		// config
 		"--chain-config-file", "/data/config.yaml",
+		// slashing protection database
+		"--datadir", "/data/db",
+	}
+	if config.RemoteSigner != "" {
+		// the public keys are loaded from the remote signer
+		cmd = append(cmd,
+			"--validators-external-signer-url", config.RemoteSigner,
+			"--validators-external-signer-public-keys", web3SignerPublicKeys(config.RemoteSigner),
+		)
+	} else {
+		// wallet dir and password
+		cmd = append(cmd,
+			"--wallet-dir", "/data",
+			"--wallet-password-file", "/data/wallet-password.txt",
+		)
+	}
+	if config.FeeRecipient != "" {
+		cmd = append(cmd, "--suggested-fee-recipient", config.FeeRecipient)
 	}
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Prysm.String()).
@@ -87,13 +107,35 @@ func NewPrysmValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 		WithTag("v2.0.6").
 		WithCmd(cmd).
 		WithMount("/data").
-		WithFile("/data/direct/accounts/all-accounts.keystore.json", keystore).
-		WithFile("/data/wallet-password.txt", defWalletPassword).
 		WithFile("/data/config.yaml", config.Spec)
 
+	if config.RemoteSigner != "" {
+		spec.WithTag(prysmRemoteSignerTag)
+		return spec, nil
+	}
+
+	keystore, err := NewPrysmWalletKeystore(config.Accounts, defWalletPassword)
+	if err != nil {
+		return nil, err
+	}
+	spec.WithFile("/data/direct/accounts/all-accounts.keystore.json", keystore).
+		WithFile("/data/wallet-password.txt", defWalletPassword)
+
 	return spec, nil
 }
 
+// NewPrysmWalletKeystore returns the keystore of an imported prysm wallet
+// (direct/accounts/all-accounts.keystore.json) with the accounts
+func NewPrysmWalletKeystore(accounts []*proto.Account, password string) ([]byte, error) {
+	store := &accountStore{}
+	for _, acct := range accounts {
+		if err := store.AddKey(acct.Bls); err != nil {
+			return nil, err
+		}
+	}
+	return store.ToKeystore(password)
+}
+
 // accountStore is the format used by all managers??
 type accountStore struct {
 	PrivateKeys [][]byte `json:"private_keys"`
@@ -131,3 +173,31 @@ func (a *accountStore) ToKeystore(password string) ([]byte, error) {
 	}
 	return keystore, nil
 }
+
+// NewPrysmSlashingExport exports the slashing protection database of the validator
+func NewPrysmSlashingExport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	// the interchange file is written as slashing_protection.json in the export dir
+	return newPrysmSlashingJob("export", "--slashing-protection-export-dir", filepath.Dir(SlashingProtectionFile)), nil
+}
+
+// NewPrysmSlashingImport imports the interchange file in the slashing protection database
+func NewPrysmSlashingImport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newPrysmSlashingJob("import", "--slashing-protection-json-file", SlashingProtectionFile), nil
+}
+
+func newPrysmSlashingJob(action, fileFlag, file string) *spec.Spec {
+	cmd := []string{
+		"slashing-protection-history", action,
+		"--accept-terms-of-use",
+		// same data dir of the validator
+		"--datadir", "/data/db",
+		fileFlag, file,
+	}
+	spec := &spec.Spec{}
+	spec.WithContainer("gcr.io/prysmaticlabs/prysm/validator").
+		WithTag("v2.0.6").
+		WithCmd(cmd).
+		WithMount("/data")
+
+	return spec
+}
@@ -31,6 +31,13 @@ func NewTekuBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 	if config.Bootnode != "" {
 		cmd = append(cmd, "--p2p-discovery-bootnodes", config.Bootnode)
 	}
+	if config.Engine != "" {
+		// engine api of the execution node
+		cmd = append(cmd,
+			"--ee-endpoint", config.Engine,
+			"--ee-jwt-secret-file", "/data/jwtsecret",
+		)
+	}
 
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Teku.String()).
@@ -43,6 +50,9 @@ func NewTekuBeacon(config *proto.BeaconConfig) (*spec.Spec, error) {
 		WithFile("/data/genesis.ssz", config.GenesisSSZ).
 		WithUser("0:0")
 
+	if config.Engine != "" {
+		spec.WithFile("/data/jwtsecret", encodeJwtSecret(config.JwtSecret))
+	}
 	return spec, nil
 }
 
@@ -55,8 +65,19 @@ func NewTekuValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 		"--data-path", "/data",
 		// config
 		"--network", "/data/config.yaml",
+	}
+	if config.RemoteSigner != "" {
+		// the public keys are loaded from the remote signer
+		cmd = append(cmd,
+			"--validators-external-signer-url", config.RemoteSigner,
+			"--validators-external-signer-public-keys", web3SignerPublicKeys(config.RemoteSigner),
+		)
+	} else {
 		// keys
-		"--validator-keys", "/data/keys:/data/pass",
+		cmd = append(cmd, "--validator-keys", "/data/keys:/data/pass")
+	}
+	if config.FeeRecipient != "" {
+		cmd = append(cmd, "--validators-proposer-default-fee-recipient", config.FeeRecipient)
 	}
 	spec := &spec.Spec{}
 	spec.WithLabel(proto.NodeClientLabel, proto.NodeClient_Teku.String()).
@@ -68,6 +89,9 @@ func NewTekuValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 		WithFile("/data/config.yaml", config.Spec).
 		WithUser("0:0")
 
+	if config.RemoteSigner != "" {
+		return spec, nil
+	}
 	for indx, acct := range config.Accounts {
 		keystore, err := bls.ToKeystore(acct.Bls, defWalletPassword)
 		if err != nil {
@@ -80,3 +104,30 @@ func NewTekuValidator(config *proto.ValidatorConfig) (*spec.Spec, error) {
 	}
 	return spec, nil
 }
+
+// NewTekuSlashingExport exports the slashing protection database of the validator
+func NewTekuSlashingExport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newTekuSlashingJob("export", "--to"), nil
+}
+
+// NewTekuSlashingImport imports the interchange file in the slashing protection database
+func NewTekuSlashingImport(config *proto.ValidatorConfig) (*spec.Spec, error) {
+	return newTekuSlashingJob("import", "--from"), nil
+}
+
+func newTekuSlashingJob(action, fileFlag string) *spec.Spec {
+	cmd := []string{
+		"slashing-protection", action,
+		// same data path of the validator
+		"--data-path", "/data",
+		fileFlag, SlashingProtectionFile,
+	}
+	spec := &spec.Spec{}
+	spec.WithContainer("consensys/teku").
+		WithTag("22.4.0").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithUser("0:0")
+
+	return spec
+}
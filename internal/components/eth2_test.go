@@ -0,0 +1,175 @@
+package components
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+func TestEth2_ValidatorKeystores(t *testing.T) {
+	beacon, err := fake.NewFake().Deploy((&spec.Spec{}).WithName("beacon"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		factory  proto.CreateValidator2
+		keystore string
+		secret   string
+	}{
+		{NewLighthouseValidator, "/data/node/validators/%s/voting-keystore.json", "/data/node/secrets/%s"},
+		{NewNimbusValidator, "/data/node/validators/%s/keystore.json", "/data/node/secrets/%s"},
+		{NewLodestarValidator, "/data/keystores/%s/voting-keystore.json", "/data/secrets/%s"},
+	}
+
+	accounts := proto.NewAccounts(2)
+	for _, c := range cases {
+		config := &proto.ValidatorConfig{
+			Accounts:     accounts,
+			Spec:         []byte("a: b"),
+			Beacon:       beacon,
+			FeeRecipient: "0x1",
+		}
+		ss, err := c.factory(config)
+		require.NoError(t, err)
+
+		for _, acct := range accounts {
+			pub := acct.Bls.PubKey()
+			pubStr := "0x" + hex.EncodeToString(pub[:])
+
+			assert.Contains(t, ss.Files, strings.Replace(c.keystore, "%s", pubStr, 1))
+			assert.Equal(t, defWalletPassword, string(ss.Files[strings.Replace(c.secret, "%s", pubStr, 1)]))
+		}
+		assert.Contains(t, ss.Cmd, beacon.GetAddr(proto.NodePortHttp))
+		assert.Contains(t, ss.Cmd, "0x1")
+	}
+}
+
+func TestEth2_BeaconEngine(t *testing.T) {
+	factories := map[proto.NodeClient]proto.CreateBeacon2{
+		proto.NodeClient_Teku:       NewTekuBeacon,
+		proto.NodeClient_Prysm:      NewPrysmBeacon,
+		proto.NodeClient_Lighthouse: NewLighthouseBeacon,
+		proto.NodeClient_Nimbus:     NewNimbusBeacon,
+		proto.NodeClient_Lodestar:   NewLodestarBeacon,
+	}
+
+	for client, factory := range factories {
+		config := &proto.BeaconConfig{
+			Spec:      []byte("a: b"),
+			Eth1:      "http://eth1",
+			Engine:    "http://engine",
+			JwtSecret: []byte{0x1},
+		}
+		ss, err := factory(config)
+		require.NoError(t, err, client.String())
+
+		assert.True(t, ss.HasLabel(proto.NodeClientLabel, client.String()))
+		assert.True(t, ss.HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()))
+		assert.Contains(t, ss.Cmd, "http://engine", client.String())
+		assert.Equal(t, "0x01", string(ss.Files["/data/jwtsecret"]), client.String())
+	}
+}
+
+func TestEth2_LodestarPreset(t *testing.T) {
+	beacon, err := fake.NewFake().Deploy((&spec.Spec{}).WithName("beacon"))
+	require.NoError(t, err)
+
+	for _, preset := range []string{"mainnet", "minimal"} {
+		bSpec, err := NewLodestarBeacon(&proto.BeaconConfig{Preset: preset})
+		require.NoError(t, err)
+
+		vSpec, err := NewLodestarValidator(&proto.ValidatorConfig{Preset: preset, Beacon: beacon})
+		require.NoError(t, err)
+
+		for _, ss := range []*spec.Spec{bSpec, vSpec} {
+			if preset == "mainnet" {
+				assert.Empty(t, ss.Env)
+			} else {
+				assert.Equal(t, []string{"LODESTAR_PRESET=minimal"}, ss.EnvList())
+			}
+		}
+	}
+}
+
+func TestEth2_RemoteSigner(t *testing.T) {
+	beacon, err := fake.NewFake().Deploy((&spec.Spec{}).WithName("beacon"))
+	require.NoError(t, err)
+
+	accounts := proto.NewAccounts(2)
+
+	signer, err := NewWeb3Signer(&proto.SignerConfig{Spec: []byte("a: b"), Accounts: accounts})
+	require.NoError(t, err)
+	assert.True(t, signer.HasLabel(proto.NodeTypeLabel, proto.NodeType_Signer.String()))
+	assert.Contains(t, string(signer.Files["/data/signer/account_1.yaml"]), "/data/keys/account_1.json")
+	assert.Contains(t, signer.Files, "/data/keys/account_1.json")
+
+	factories := []proto.CreateValidator2{
+		NewTekuValidator,
+		NewPrysmValidator,
+		NewLighthouseValidator,
+	}
+	for _, factory := range factories {
+		config := &proto.ValidatorConfig{
+			Accounts:     accounts,
+			Spec:         []byte("a: b"),
+			Beacon:       beacon,
+			RemoteSigner: "http://signer",
+		}
+		ss, err := factory(config)
+		require.NoError(t, err)
+
+		// the keys are not part of the validator
+		for path := range ss.Files {
+			assert.NotContains(t, path, "keystore")
+		}
+
+		if ss.HasLabel(proto.NodeClientLabel, proto.NodeClient_Lighthouse.String()) {
+			definitions := string(ss.Files["/data/node/validators/validator_definitions.yml"])
+			for _, acct := range accounts {
+				pub := acct.Bls.PubKey()
+				assert.Contains(t, definitions, "0x"+hex.EncodeToString(pub[:]))
+			}
+			assert.Contains(t, definitions, `url: "http://signer"`)
+		} else {
+			assert.Contains(t, ss.Cmd, "http://signer")
+			assert.Contains(t, ss.Cmd, "http://signer/api/v1/eth2/publicKeys")
+		}
+	}
+}
+
+func TestEth2_SlashingProtection(t *testing.T) {
+	beacon, err := fake.NewFake().Deploy((&spec.Spec{}).WithName("beacon"))
+	require.NoError(t, err)
+
+	factories := []proto.CreateValidator2{
+		NewTekuSlashingExport, NewTekuSlashingImport,
+		NewLighthouseSlashingExport, NewLighthouseSlashingImport,
+		NewNimbusSlashingExport, NewNimbusSlashingImport,
+		NewLodestarSlashingExport, NewLodestarSlashingImport,
+		NewPrysmSlashingImport,
+	}
+
+	config := &proto.ValidatorConfig{
+		Spec:       []byte("a: b"),
+		Beacon:     beacon,
+		GenesisSSZ: []byte{0x1},
+	}
+	for _, factory := range factories {
+		ss, err := factory(config)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"/data"}, ss.Mount)
+		assert.Contains(t, ss.Cmd, SlashingProtectionFile)
+	}
+
+	// prysm writes the interchange file in the export dir
+	ss, err := NewPrysmSlashingExport(config)
+	require.NoError(t, err)
+	assert.Contains(t, ss.Cmd, "/data")
+	assert.Equal(t, "/data/slashing_protection.json", SlashingProtectionFile)
+}
@@ -0,0 +1,5 @@
+package components
+
+// SlashingProtectionFile is the path of the EIP-3076 interchange file written
+// by the slashing protection export jobs and read by the import jobs
+const SlashingProtectionFile = "/data/slashing_protection.json"
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// Web3SignerClient is the client name of the web3signer nodes
+const Web3SignerClient = "Web3Signer"
+
+// NewWeb3Signer creates a new web3signer remote signer with the keys of the accounts
+func NewWeb3Signer(config *proto.SignerConfig) (*spec.Spec, error) {
+	cmd := []string{
+		"--http-listen-host", "0.0.0.0",
+		"--http-listen-port", `{{ Port "web3signer.http" }}`,
+		"--http-host-allowlist", "*",
+		// directory with the configuration files of the keys
+		"--key-store-path", "/data/signer",
+		"eth2",
+		// config
+		"--network", "/data/config.yaml",
+		// the validators keep their own slashing protection database
+		"--slashing-protection-enabled", "false",
+	}
+	ss := &spec.Spec{}
+	ss.WithLabel(proto.NodeClientLabel, Web3SignerClient).
+		WithLabel(proto.NodeTypeLabel, proto.NodeType_Signer.String()).
+		WithContainer("consensys/web3signer").
+		WithTag("22.11.0").
+		WithCmd(cmd).
+		WithMount("/data").
+		WithFile("/data/config.yaml", config.Spec).
+		WithUser("0:0").
+		WithRetry(func(n spec.Node) error {
+			return testUpcheck(n.GetAddr(proto.NodePortWeb3Signer))
+		})
+
+	for indx, acct := range config.Accounts {
+		keystore, err := bls.ToKeystore(acct.Bls, defWalletPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("account_%d", indx)
+		ss.WithFile("/data/keys/"+name+".json", keystore).
+			WithFile("/data/pass/"+name+".txt", defWalletPassword).
+			WithFile("/data/signer/"+name+".yaml", web3SignerKeyConfig(name))
+	}
+	return ss, nil
+}
+
+// web3SignerKeyConfig is the configuration file of a key stored in a keystore
+func web3SignerKeyConfig(name string) string {
+	return fmt.Sprintf(`type: "file-keystore"
+keyType: "BLS"
+keystoreFile: "/data/keys/%s.json"
+keystorePasswordFile: "/data/pass/%s.txt"
+`, name, name)
+}
+
+// web3SignerPublicKeys returns the endpoint of the signer with its public keys
+func web3SignerPublicKeys(addr string) string {
+	return addr + "/api/v1/eth2/publicKeys"
+}
+
+func testUpcheck(addr string) error {
+	resp, err := http.Get(addr + "/upcheck")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("upcheck failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -3,17 +3,21 @@ package docker
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/strslice"
 	"github.com/docker/docker/client"
@@ -23,25 +27,43 @@ import (
 	"github.com/umbracle/viewpoint/internal/spec"
 )
 
-type exitResult struct {
-	err error
-}
-
 type node struct {
-	cli        *client.Client
-	id         string
-	opts       *spec.Spec
+	cli      *client.Client
+	logger   hclog.Logger
+	id       string
+	opts     *spec.Spec
+	mountMap map[string]string
+
+	// ports are the ports used in the command of the node
+	ports map[string]uint64
+
+	lock       sync.Mutex
 	ip         string
 	waitCh     chan struct{}
-	exitResult *exitResult
-	mountMap   map[string]string
+	exitResult *spec.ExitResult
+	logsSince  string
 }
 
+var _ spec.Runtime = &Docker{}
+
 type Docker struct {
 	cli    *client.Client
 	logger hclog.Logger
+
+	// lock serializes the allocation of the ips of the containers
+	lock sync.Mutex
 }
 
+var (
+	// networkName is the docker network of the containers. The containers have a
+	// static ip in the network so that they keep their address when they restart
+	// and the nodes that reference them in their flags can still reach them.
+	networkName = "viewpoint"
+
+	// networkSubnet is the subnet of the network if it does not exist
+	networkSubnet = "172.30.0.0/16"
+)
+
 func NewDocker() (*Docker, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv)
 	if err != nil {
@@ -58,7 +80,7 @@ func (d *Docker) SetLogger(logger hclog.Logger) {
 	d.logger = logger
 }
 
-func (d *Docker) Deploy(spec *spec.Spec) (*node, error) {
+func (d *Docker) Deploy(spec *spec.Spec) (spec.Node, error) {
 	ctx := context.Background()
 
 	if spec.Tag == "" {
@@ -128,9 +150,11 @@ func (d *Docker) Deploy(spec *spec.Spec) (*node, error) {
 
 	n := &node{
 		cli:      d.cli,
+		logger:   d.logger,
 		opts:     spec,
 		waitCh:   make(chan struct{}),
 		mountMap: mountMap,
+		ports:    map[string]uint64{},
 	}
 
 	// build CLI arguments which might include template arguments
@@ -148,46 +172,37 @@ func (d *Docker) Deploy(spec *spec.Spec) (*node, error) {
 		Cmd:    strslice.StrSlice(cmdArgs),
 		Labels: spec.Labels,
 		User:   spec.User,
+		Env:    spec.EnvList(),
 	}
 	if len(spec.Entrypoint) != 0 {
 		config.Entrypoint = strslice.StrSlice(spec.Entrypoint)
 	}
 	hostConfig := &container.HostConfig{
-		Binds: []string{},
+		Binds:       []string{},
+		NetworkMode: container.NetworkMode(networkName),
 	}
 
 	for mount, local := range mountMap {
 		hostConfig.Binds = append(hostConfig.Binds, local+":"+mount)
 	}
 
-	body, err := d.cli.ContainerCreate(ctx, config, hostConfig, &network.NetworkingConfig{}, nil, "")
-	if err != nil {
-		return nil, fmt.Errorf("could not create container: %v", err)
+	if err := d.createContainer(ctx, n, config, hostConfig); err != nil {
+		return nil, err
 	}
-	n.id = body.ID
 
 	// start container
 	if err := d.cli.ContainerStart(ctx, n.id, types.ContainerStartOptions{}); err != nil {
 		return nil, fmt.Errorf("could not start container: %v", err)
 	}
 
-	data, err := d.cli.ContainerInspect(ctx, body.ID)
+	data, err := d.cli.ContainerInspect(ctx, n.id)
 	if err != nil {
 		return nil, err
 	}
 
-	n.ip = data.NetworkSettings.IPAddress
-
-	go n.run()
+	n.ip = containerIP(data)
 
-	if len(spec.Output) != 0 {
-		// track the logs to output
-		go func() {
-			if err := n.trackOutput(); err != nil {
-				d.logger.Error("failed to log container", "id", n.id, "err", err)
-			}
-		}()
-	}
+	n.track(n.waitCh)
 
 	if spec.Retry != nil {
 		if err := n.retryFn(defaultTimeoutDuration, func() error {
@@ -200,6 +215,224 @@ func (d *Docker) Deploy(spec *spec.Spec) (*node, error) {
 	return n, nil
 }
 
+// Attach tracks an existing container. If the container is not running,
+// the node is returned as exited and it can be started again with Start.
+func (d *Docker) Attach(spec *spec.Spec, id string) (spec.Node, error) {
+	ctx := context.Background()
+
+	data, err := d.cli.ContainerInspect(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range spec.Labels {
+		if data.Config.Labels[k] != v {
+			return nil, fmt.Errorf("container '%s' label '%s' does not match", id, k)
+		}
+	}
+
+	mountMap := map[string]string{}
+	for _, mount := range data.Mounts {
+		mountMap[mount.Destination] = mount.Source
+	}
+
+	n := &node{
+		cli:      d.cli,
+		logger:   d.logger,
+		id:       id,
+		opts:     spec,
+		ip:       containerIP(data),
+		waitCh:   make(chan struct{}),
+		mountMap: mountMap,
+		ports:    map[string]uint64{},
+		// only track the logs from now on
+		logsSince: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	// the ports of the node are the ones used in the command of the spec
+	// (as in Deploy) and the ones bound or exposed by the container
+	for _, cmd := range spec.Cmd {
+		if _, err := n.execCmd(cmd); err != nil {
+			return nil, err
+		}
+	}
+	for port := range data.NetworkSettings.Ports {
+		if name, ok := defPortName(uint64(port.Int())); ok {
+			if _, ok := n.ports[name]; !ok {
+				n.ports[name] = uint64(port.Int())
+			}
+		}
+	}
+
+	if !data.State.Running {
+		n.exitResult = containerExitResult(data.State)
+		close(n.waitCh)
+		return n, nil
+	}
+
+	n.track(n.waitCh)
+
+	return n, nil
+}
+
+// createContainer creates the container of the node with the next
+// free ip of the network of the runtime
+func (d *Docker) createContainer(ctx context.Context, n *node, config *container.Config, hostConfig *container.HostConfig) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	subnet, err := d.ensureNetwork(ctx)
+	if err != nil {
+		return err
+	}
+	used, err := d.usedIPs(ctx)
+	if err != nil {
+		return err
+	}
+	ip, err := nextFreeIP(subnet, used)
+	if err != nil {
+		return err
+	}
+
+	netConfig := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			networkName: {
+				IPAMConfig: &network.EndpointIPAMConfig{IPv4Address: ip},
+			},
+		},
+	}
+	body, err := d.cli.ContainerCreate(ctx, config, hostConfig, netConfig, nil, "")
+	if err != nil {
+		return fmt.Errorf("could not create container: %v", err)
+	}
+	n.id = body.ID
+	return nil
+}
+
+// ensureNetwork creates the network of the runtime if it does not exist and returns its subnet
+func (d *Docker) ensureNetwork(ctx context.Context) (*net.IPNet, error) {
+	res, err := d.cli.NetworkInspect(ctx, networkName, types.NetworkInspectOptions{})
+	if client.IsErrNotFound(err) {
+		opts := types.NetworkCreate{
+			CheckDuplicate: true,
+			Driver:         "bridge",
+			IPAM: &network.IPAM{
+				Config: []network.IPAMConfig{{Subnet: networkSubnet}},
+			},
+		}
+		if _, err := d.cli.NetworkCreate(ctx, networkName, opts); err != nil {
+			return nil, fmt.Errorf("could not create network: %v", err)
+		}
+		_, subnet, err := net.ParseCIDR(networkSubnet)
+		return subnet, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(res.IPAM.Config) == 0 {
+		return nil, fmt.Errorf("network '%s' does not have a subnet", networkName)
+	}
+	_, subnet, err := net.ParseCIDR(res.IPAM.Config[0].Subnet)
+	return subnet, err
+}
+
+// usedIPs returns the ips of the containers of the network. The stopped containers
+// are not connected to the network but they keep their static ip.
+func (d *Docker) usedIPs(ctx context.Context) (map[string]bool, error) {
+	opts := types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("network", networkName)),
+	}
+	containers, err := d.cli.ContainerList(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	used := map[string]bool{}
+	for _, c := range containers {
+		if c.NetworkSettings == nil {
+			continue
+		}
+		endpoint, ok := c.NetworkSettings.Networks[networkName]
+		if !ok || endpoint == nil {
+			continue
+		}
+		if endpoint.IPAMConfig != nil && endpoint.IPAMConfig.IPv4Address != "" {
+			used[endpoint.IPAMConfig.IPv4Address] = true
+		}
+		if endpoint.IPAddress != "" {
+			used[endpoint.IPAddress] = true
+		}
+	}
+	return used, nil
+}
+
+// nextFreeIP returns the first ip of the subnet that is not used. The
+// first address of the subnet is the gateway of the network.
+func nextFreeIP(subnet *net.IPNet, used map[string]bool) (string, error) {
+	base := subnet.IP.To4()
+	if base == nil {
+		return "", fmt.Errorf("subnet %s is not ipv4", subnet)
+	}
+	ones, bits := subnet.Mask.Size()
+	size := uint32(1) << uint(bits-ones)
+
+	start := binary.BigEndian.Uint32(base)
+	// skip the network address, the gateway and the broadcast address
+	for i := uint32(2); i < size-1; i++ {
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, start+i)
+		if !used[ip.String()] {
+			return ip.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no free ip in subnet %s", subnet)
+}
+
+// containerIP returns the ip of the container in the network of the runtime
+func containerIP(data types.ContainerJSON) string {
+	if data.NetworkSettings == nil {
+		return ""
+	}
+	if endpoint, ok := data.NetworkSettings.Networks[networkName]; ok && endpoint != nil {
+		if endpoint.IPAddress != "" {
+			return endpoint.IPAddress
+		}
+		// the stopped containers are not connected but keep their static ip
+		if endpoint.IPAMConfig != nil {
+			return endpoint.IPAMConfig.IPv4Address
+		}
+	}
+	return data.NetworkSettings.IPAddress
+}
+
+// containerExitResult returns the exit result of a container that is not running
+func containerExitResult(state *types.ContainerState) *spec.ExitResult {
+	result := &spec.ExitResult{
+		ExitCode: int64(state.ExitCode),
+	}
+	if state.Error != "" {
+		result.Err = fmt.Errorf(state.Error)
+	}
+	return result
+}
+
+// track waits for the container to exit and tracks its logs to the output
+func (n *node) track(waitCh chan struct{}) {
+	go n.run(waitCh)
+
+	if len(n.opts.Output) != 0 {
+		// track the logs to output
+		go func() {
+			if err := n.trackOutput(); err != nil {
+				n.logger.Error("failed to log container", "id", n.id, "err", err)
+			}
+		}()
+	}
+}
+
+func (n *node) ID() string {
+	return n.id
+}
+
 func (n *node) Spec() *spec.Spec {
 	return n.opts
 }
@@ -212,6 +445,21 @@ var defPorts = map[string]uint64{
 	"eth2.p2p":        20202,
 	"eth2.http":       9545,
 	"eth2.prysm.grpc": 9546,
+	"web3signer.http": 9000,
+}
+
+// defPortName returns the name of a default port if only one name uses it
+func defPortName(port uint64) (string, bool) {
+	found := []string{}
+	for name, defPort := range defPorts {
+		if defPort == port {
+			found = append(found, name)
+		}
+	}
+	if len(found) != 1 {
+		return "", false
+	}
+	return found[0], true
 }
 
 func (n *node) execCmd(cmd string) (string, error) {
@@ -222,6 +470,7 @@ func (n *node) execCmd(cmd string) (string, error) {
 			if !ok {
 				panic(fmt.Errorf("port '%s' not found", name))
 			}
+			n.ports[string(name)] = port
 			return fmt.Sprintf("%d", port)
 		},
 	})
@@ -239,26 +488,63 @@ func (n *node) execCmd(cmd string) (string, error) {
 }
 
 func (n *node) WaitCh() <-chan struct{} {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	return n.waitCh
 }
 
-func (n *node) run() {
+func (n *node) run(waitCh chan struct{}) {
 	resCh, errCh := n.cli.ContainerWait(context.Background(), n.id, container.WaitConditionNotRunning)
 
-	var exitErr error
+	result := &spec.ExitResult{}
 	select {
 	case res := <-resCh:
+		result.ExitCode = res.StatusCode
 		if res.Error != nil {
-			exitErr = fmt.Errorf(res.Error.Message)
+			result.Err = fmt.Errorf(res.Error.Message)
 		}
 	case err := <-errCh:
-		exitErr = err
+		result.Err = err
 	}
 
-	n.exitResult = &exitResult{
-		err: exitErr,
+	n.lock.Lock()
+	n.exitResult = result
+	n.lock.Unlock()
+
+	close(waitCh)
+}
+
+func (n *node) ExitResult() *spec.ExitResult {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.exitResult
+}
+
+func (n *node) Info() (*spec.NodeInfo, error) {
+	data, err := n.cli.ContainerInspect(context.Background(), n.id)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &spec.NodeInfo{
+		Image:  data.Config.Image,
+		State:  data.State.Status,
+		Mounts: map[string]string{},
+		Ports:  map[string]string{},
 	}
-	close(n.waitCh)
+	if info.StartedAt, err = time.Parse(time.RFC3339Nano, data.State.StartedAt); err != nil {
+		return nil, fmt.Errorf("failed to parse start time: %v", err)
+	}
+	for _, mount := range data.Mounts {
+		info.Mounts[mount.Destination] = mount.Source
+	}
+	ip := n.IP()
+	for name, port := range n.ports {
+		info.Ports[name] = fmt.Sprintf("%s:%d", ip, port)
+	}
+	return info, nil
 }
 
 func (n *node) GetAddr(portName string) string {
@@ -266,7 +552,7 @@ func (n *node) GetAddr(portName string) string {
 	if !ok {
 		panic(fmt.Errorf("port '%s' not found", portName))
 	}
-	return fmt.Sprintf("http://%s:%d", n.ip, port)
+	return fmt.Sprintf("http://%s:%d", n.IP(), port)
 }
 
 func (n *node) Stop() error {
@@ -274,16 +560,76 @@ func (n *node) Stop() error {
 		fmt.Println("-- err -", err)
 		return fmt.Errorf("failed to stop container: %v", err)
 	}
+	<-n.WaitCh()
+	return nil
+}
+
+// Start starts again the container of a stopped node. The binds of the
+// container are kept so the node starts with the same data.
+func (n *node) Start() error {
+	select {
+	case <-n.WaitCh():
+	default:
+		return fmt.Errorf("node is already running")
+	}
+
+	ctx := context.Background()
+	logsSince := time.Now().UTC().Format(time.RFC3339)
+
+	if err := n.cli.ContainerStart(ctx, n.id, types.ContainerStartOptions{}); err != nil {
+		return fmt.Errorf("could not start container: %v", err)
+	}
+	data, err := n.cli.ContainerInspect(ctx, n.id)
+	if err != nil {
+		return err
+	}
+
+	waitCh := make(chan struct{})
+
+	n.lock.Lock()
+	// the static ip of the container does not change unless
+	// it was created out of the network of the runtime
+	n.ip = containerIP(data)
+	n.waitCh = waitCh
+	n.exitResult = nil
+	n.logsSince = logsSince
+	n.lock.Unlock()
+
+	n.track(waitCh)
+
+	if n.opts.Retry != nil {
+		if err := n.retryFn(defaultTimeoutDuration, func() error {
+			return n.opts.Retry(n)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove stops and removes the container of the node
+func (n *node) Remove() error {
+	opts := types.ContainerRemoveOptions{
+		Force: true,
+	}
+	if err := n.cli.ContainerRemove(context.Background(), n.id, opts); err != nil {
+		return fmt.Errorf("failed to remove container: %v", err)
+	}
 	return nil
 }
 
 func (n *node) trackOutput() error {
 	writer := io.MultiWriter(n.opts.Output...)
 
+	n.lock.Lock()
+	logsSince := n.logsSince
+	n.lock.Unlock()
+
 	opts := types.ContainerLogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
 		Follow:     true,
+		Since:      logsSince,
 	}
 	out, err := n.cli.ContainerLogs(context.Background(), n.id, opts)
 	if err != nil {
@@ -310,6 +656,9 @@ func (n *node) GetLogs() (string, error) {
 }
 
 func (n *node) IP() string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	return n.ip
 }
 
@@ -317,6 +666,7 @@ var defaultTimeoutDuration = 1 * time.Minute
 
 func (n *node) retryFn(timeout time.Duration, handler func() error) error {
 	timeoutT := time.NewTimer(timeout)
+	waitCh := n.WaitCh()
 
 	for {
 		select {
@@ -325,7 +675,7 @@ func (n *node) retryFn(timeout time.Duration, handler func() error) error {
 				return nil
 			}
 
-		case <-n.waitCh:
+		case <-waitCh:
 			return fmt.Errorf("node stopped")
 
 		case <-timeoutT.C:
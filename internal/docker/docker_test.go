@@ -0,0 +1,67 @@
+package docker
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+func TestDocker_NextFreeIP(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("172.30.0.0/30")
+	require.NoError(t, err)
+
+	// the network address and the gateway are skipped
+	ip, err := nextFreeIP(subnet, map[string]bool{})
+	require.NoError(t, err)
+	assert.Equal(t, "172.30.0.2", ip)
+
+	// the subnet only has one address for the containers
+	_, err = nextFreeIP(subnet, map[string]bool{"172.30.0.2": true})
+	require.Error(t, err)
+
+	_, subnet, err = net.ParseCIDR("172.30.0.0/16")
+	require.NoError(t, err)
+
+	ip, err = nextFreeIP(subnet, map[string]bool{"172.30.0.2": true, "172.30.0.3": true, "172.30.0.255": false})
+	require.NoError(t, err)
+	assert.Equal(t, "172.30.0.4", ip)
+}
+
+func TestDocker_RestartKeepsIP(t *testing.T) {
+	d, err := NewDocker()
+	require.NoError(t, err)
+
+	deploy := func() spec.Node {
+		ss := (&spec.Spec{}).
+			WithContainer("busybox").
+			WithCmd([]string{"sleep", "300"})
+
+		n, err := d.Deploy(ss)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			n.Remove()
+		})
+		return n
+	}
+
+	n := deploy()
+	ip := n.IP()
+	require.NotEmpty(t, ip)
+
+	// the ip of the stopped node is not used by the next nodes
+	require.NoError(t, n.Stop())
+	other := deploy()
+	assert.NotEqual(t, ip, other.IP())
+
+	// the node has the same ip after it restarts
+	require.NoError(t, n.Start())
+	assert.Equal(t, ip, n.IP())
+
+	// and when it is attached again
+	attached, err := d.Attach(n.Spec(), n.ID())
+	require.NoError(t, err)
+	assert.Equal(t, ip, attached.IP())
+}
@@ -0,0 +1,397 @@
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+var _ spec.Runtime = &Fake{}
+
+// Fake is an in-memory runtime that does not run any process. It records
+// the deployed specs so that the server can be tested without Docker.
+type Fake struct {
+	lock  sync.Mutex
+	nodes []*Node
+
+	// Hook is called for every new node before the retry function of the spec
+	// runs. It can be used to set the logs, the addresses or to exit the node.
+	Hook func(n *Node)
+
+	// RetryTimeout is the maximum amount of time to wait for the retry function
+	RetryTimeout time.Duration
+
+	// DataDir is the directory where the files of the mounts of the nodes are
+	// written. If empty, the files are not written and the mounts do not exist.
+	DataDir string
+}
+
+func NewFake() *Fake {
+	f := &Fake{
+		nodes:        []*Node{},
+		RetryTimeout: 5 * time.Second,
+	}
+	return f
+}
+
+func (f *Fake) newNode(spec *spec.Spec, id string) *Node {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	num := len(f.nodes)
+	if id == "" {
+		id = fmt.Sprintf("fake-%d", num)
+	}
+	dataDir := ""
+	if f.DataDir != "" {
+		dataDir = filepath.Join(f.DataDir, id)
+	}
+	n := &Node{
+		id:        id,
+		dataDir:   dataDir,
+		opts:      spec,
+		ip:        fmt.Sprintf("10.0.%d.%d", num/250, num%250+2),
+		addrs:     map[string]string{},
+		waitCh:    make(chan struct{}),
+		startedAt: time.Now(),
+		// used by Start to run the retry function again
+		retryTimeout: f.RetryTimeout,
+	}
+	f.nodes = append(f.nodes, n)
+	return n
+}
+
+func (f *Fake) Deploy(spec *spec.Spec) (spec.Node, error) {
+	n := f.newNode(spec, "")
+
+	f.lock.Lock()
+	hook := f.Hook
+	f.lock.Unlock()
+
+	if err := n.writeFiles(); err != nil {
+		return nil, err
+	}
+
+	if hook != nil {
+		hook(n)
+	}
+
+	if spec.Retry != nil {
+		if err := n.retryFn(f.RetryTimeout, func() error {
+			return spec.Retry(n)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// Attach returns the node with the given id, with its spec and in its current state, if it
+// was deployed with the runtime or records a new node as if it was already running
+func (f *Fake) Attach(spec *spec.Spec, id string) (spec.Node, error) {
+	f.lock.Lock()
+	for _, n := range f.nodes {
+		if n.id != id {
+			continue
+		}
+		f.lock.Unlock()
+
+		if n.IsRemoved() {
+			return nil, fmt.Errorf("node '%s' not found", id)
+		}
+		return n, nil
+	}
+	f.lock.Unlock()
+
+	return f.newNode(spec, id), nil
+}
+
+// Nodes returns all the nodes deployed with the runtime
+func (f *Fake) Nodes() []*Node {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]*Node{}, f.nodes...)
+}
+
+// Specs returns the specs of all the nodes deployed with the runtime
+func (f *Fake) Specs() []*spec.Spec {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	res := []*spec.Spec{}
+	for _, n := range f.nodes {
+		res = append(res, n.opts)
+	}
+	return res
+}
+
+// Node is a fake node deployed by the Fake runtime
+type Node struct {
+	lock     sync.Mutex
+	id       string
+	opts     *spec.Spec
+	ip       string
+	addrs    map[string]string
+	logs     bytes.Buffer
+	waitCh   chan struct{}
+	exited   bool
+	exitRes  *spec.ExitResult
+	stopped  bool
+	removed  bool
+	nextPort uint64
+	dataDir  string
+
+	startedAt time.Time
+
+	retryTimeout time.Duration
+}
+
+func (n *Node) ID() string {
+	return n.id
+}
+
+func (n *Node) Spec() *spec.Spec {
+	return n.opts
+}
+
+func (n *Node) IP() string {
+	return n.ip
+}
+
+// SetAddr overrides the address returned by GetAddr for the given port
+func (n *Node) SetAddr(port string, addr string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.addrs[port] = addr
+}
+
+func (n *Node) GetAddr(port string) string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	addr, ok := n.addrs[port]
+	if !ok {
+		// assign a new fake port the first time it is used
+		addr = fmt.Sprintf("http://%s:%d", n.ip, 8000+n.nextPort)
+		n.addrs[port] = addr
+		n.nextPort++
+	}
+	return addr
+}
+
+// WriteLogs appends logs to the node as if it was the output of the process
+func (n *Node) WriteLogs(logs string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.logs.WriteString(logs)
+	if len(n.opts.Output) != 0 {
+		io.MultiWriter(n.opts.Output...).Write([]byte(logs))
+	}
+}
+
+func (n *Node) GetLogs() (string, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.logs.String(), nil
+}
+
+func (n *Node) WaitCh() <-chan struct{} {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.waitCh
+}
+
+// Exit simulates the exit of the node with the given error. The
+// exit code is 1 if there is an error.
+func (n *Node) Exit(err error) {
+	code := int64(0)
+	if err != nil {
+		code = 1
+	}
+	n.ExitWithCode(code, err)
+}
+
+// ExitWithCode simulates the exit of the node with the given exit code and error
+func (n *Node) ExitWithCode(code int64, err error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.exitLocked(&spec.ExitResult{ExitCode: code, Err: err})
+}
+
+func (n *Node) exitLocked(res *spec.ExitResult) {
+	if n.exited {
+		return
+	}
+	n.exited = true
+	n.exitRes = res
+	close(n.waitCh)
+}
+
+func (n *Node) ExitResult() *spec.ExitResult {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.exitRes
+}
+
+func (n *Node) Info() (*spec.NodeInfo, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	state := "running"
+	if n.exited {
+		state = "exited"
+	}
+	info := &spec.NodeInfo{
+		Image:     n.opts.Repository + ":" + n.opts.Tag,
+		State:     state,
+		StartedAt: n.startedAt,
+		Mounts:    map[string]string{},
+		Ports:     map[string]string{},
+	}
+	for _, mount := range n.opts.Mount {
+		info.Mounts[mount] = n.mountPath(mount)
+	}
+	for port, addr := range n.addrs {
+		info.Ports[port] = addr
+	}
+	return info, nil
+}
+
+func (n *Node) Stop() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.stopped = true
+	n.exitLocked(&spec.ExitResult{})
+	return nil
+}
+
+// IsStopped returns true if the node was stopped with Stop
+func (n *Node) IsStopped() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.stopped
+}
+
+func (n *Node) Start() error {
+	n.lock.Lock()
+	if !n.exited {
+		n.lock.Unlock()
+		return fmt.Errorf("node is already running")
+	}
+	n.exited = false
+	n.exitRes = nil
+	n.stopped = false
+	n.waitCh = make(chan struct{})
+	n.startedAt = time.Now()
+	n.lock.Unlock()
+
+	if n.opts.Retry != nil {
+		if err := n.retryFn(n.retryTimeout, func() error {
+			return n.opts.Retry(n)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Node) Remove() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.removed = true
+	n.exitLocked(&spec.ExitResult{})
+	return nil
+}
+
+// IsRemoved returns true if the node was removed with Remove
+func (n *Node) IsRemoved() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.removed
+}
+
+// mountPath returns the path in the host of a mount of the node
+func (n *Node) mountPath(mount string) string {
+	if n.dataDir == "" {
+		return "/fake" + mount
+	}
+	return filepath.Join(n.dataDir, mount)
+}
+
+// WriteFile writes a file in the mounts of the node as if it was written by the process
+func (n *Node) WriteFile(path string, content []byte) error {
+	for _, mount := range n.opts.Mount {
+		if !strings.HasPrefix(path, mount) {
+			continue
+		}
+		if n.dataDir == "" {
+			return fmt.Errorf("the runtime does not have a data dir")
+		}
+		localPath := filepath.Join(n.mountPath(mount), strings.TrimPrefix(path, mount))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0700); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(localPath, content, 0644)
+	}
+	return fmt.Errorf("mount match for '%s' not found", path)
+}
+
+// writeFiles writes the files of the spec in the mounts if the runtime has a data dir
+func (n *Node) writeFiles() error {
+	if n.dataDir == "" {
+		return nil
+	}
+	for _, mount := range n.opts.Mount {
+		if err := os.MkdirAll(n.mountPath(mount), 0755); err != nil {
+			return err
+		}
+	}
+	for path, content := range n.opts.Files {
+		if err := n.WriteFile(path, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Node) retryFn(timeout time.Duration, handler func() error) error {
+	timeoutT := time.NewTimer(timeout)
+	defer timeoutT.Stop()
+
+	waitCh := n.WaitCh()
+
+	for {
+		if err := handler(); err == nil {
+			return nil
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+
+		case <-waitCh:
+			return fmt.Errorf("node stopped")
+
+		case <-timeoutT.C:
+			return fmt.Errorf("timeout")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package genesis
+
+import (
+	"fmt"
+	"math/big"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/umbracle/ethgo"
+	consensus "github.com/umbracle/go-eth-consensus"
+)
+
+// maxTransactionsPerPayload is the max number of transactions in an execution payload
+const maxTransactionsPerPayload = 1048576
+
+// ExecutionBlock is an execution block as returned by the eth_getBlockByNumber
+// endpoint. It includes the fields of the header that are not available
+// in ethgo.Block and are required to build an execution payload header.
+type ExecutionBlock struct {
+	Hash          ethgo.Hash      `json:"hash"`
+	ParentHash    ethgo.Hash      `json:"parentHash"`
+	Miner         ethgo.Address   `json:"miner"`
+	StateRoot     ethgo.Hash      `json:"stateRoot"`
+	ReceiptsRoot  ethgo.Hash      `json:"receiptsRoot"`
+	LogsBloom     ethgo.ArgBytes  `json:"logsBloom"`
+	MixHash       ethgo.Hash      `json:"mixHash"`
+	Number        ethgo.ArgUint64 `json:"number"`
+	GasLimit      ethgo.ArgUint64 `json:"gasLimit"`
+	GasUsed       ethgo.ArgUint64 `json:"gasUsed"`
+	Timestamp     ethgo.ArgUint64 `json:"timestamp"`
+	ExtraData     ethgo.ArgBytes  `json:"extraData"`
+	BaseFeePerGas *ethgo.ArgBig   `json:"baseFeePerGas"`
+	Transactions  []ethgo.Hash    `json:"transactions"`
+}
+
+// PayloadHeader returns the execution payload header of the block. Only blocks
+// without transactions are supported since the header is used to start
+// the beacon chain from the genesis block of the execution chain.
+func (e *ExecutionBlock) PayloadHeader() (*consensus.ExecutionPayloadHeader, error) {
+	if len(e.Transactions) != 0 {
+		return nil, fmt.Errorf("execution block with transactions is not supported")
+	}
+	if e.BaseFeePerGas == nil {
+		return nil, fmt.Errorf("execution block does not have base fee")
+	}
+
+	if len(e.LogsBloom) != 256 {
+		return nil, fmt.Errorf("logs bloom of %d bytes, expected 256", len(e.LogsBloom))
+	}
+	if len(e.ExtraData) > 32 {
+		return nil, fmt.Errorf("extra data of %d bytes, expected at most 32", len(e.ExtraData))
+	}
+
+	baseFee := (*big.Int)(e.BaseFeePerGas)
+	if baseFee.BitLen() > 256 {
+		return nil, fmt.Errorf("base fee does not fit in 256 bits")
+	}
+	var baseFeePerGas consensus.Uint256
+	// the base fee is encoded in little endian
+	baseFeeBytes := baseFee.Bytes()
+	for i, b := range baseFeeBytes {
+		baseFeePerGas[len(baseFeeBytes)-1-i] = b
+	}
+
+	transactionsRoot, err := emptyTransactionsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	header := &consensus.ExecutionPayloadHeader{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.Miner,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		PrevRandao:       e.MixHash,
+		BlockNumber:      e.Number.Uint64(),
+		GasLimit:         e.GasLimit.Uint64(),
+		GasUsed:          e.GasUsed.Uint64(),
+		Timestamp:        e.Timestamp.Uint64(),
+		ExtraData:        e.ExtraData,
+		BaseFeePerGas:    baseFeePerGas,
+		BlockHash:        e.Hash,
+		TransactionsRoot: transactionsRoot,
+	}
+	copy(header.LogsBloom[:], e.LogsBloom)
+	return header, nil
+}
+
+// emptyTransactionsRoot returns the hash tree root of an empty list of transactions
+func emptyTransactionsRoot() ([32]byte, error) {
+	hh := ssz.NewHasher()
+
+	indx := hh.Index()
+	hh.MerkleizeWithMixin(indx, 0, maxTransactionsPerPayload)
+
+	return hh.HashRoot()
+}
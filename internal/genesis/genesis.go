@@ -18,6 +18,14 @@ type Input struct {
 	InitialValidator []*proto.Account
 	Fork             proto.Fork
 	ForkVersion      [4]byte
+
+	// Preset sets the size of the vectors of the state. It defaults
+	// to the mainnet preset.
+	Preset *Preset
+
+	// ExecutionPayloadHeader is the header of the execution block used
+	// to start the chain at the merge (Bellatrix fork)
+	ExecutionPayloadHeader *consensus.ExecutionPayloadHeader
 }
 
 var emptyDepositRoot = [32]byte{}
@@ -70,12 +78,14 @@ func GenerateGenesis(input *Input) (ssz.Marshaler, error) {
 		CurrentVersion: input.ForkVersion,
 	}
 
+	preset := input.Preset
+	if preset == nil {
+		preset = MainnetPreset
+	}
+
 	var state ssz.Marshaler
 	if input.Fork == proto.Fork_Phase0 {
-		body := consensus.BeaconBlockBodyPhase0{
-			Eth1Data: &consensus.Eth1Data{},
-		}
-		bodyRoot, err := body.HashTreeRoot()
+		bodyRoot, err := preset.emptyBodyRoot(input.Fork)
 		if err != nil {
 			return nil, err
 		}
@@ -96,11 +106,7 @@ func GenerateGenesis(input *Input) (ssz.Marshaler, error) {
 			Slashings:  slashings,
 		}
 	} else if input.Fork == proto.Fork_Altair {
-		body := consensus.BeaconBlockBodyAltair{
-			Eth1Data:      &consensus.Eth1Data{},
-			SyncAggregate: &consensus.SyncAggregate{},
-		}
-		bodyRoot, err := body.HashTreeRoot()
+		bodyRoot, err := preset.emptyBodyRoot(input.Fork)
 		if err != nil {
 			return nil, err
 		}
@@ -120,8 +126,39 @@ func GenerateGenesis(input *Input) (ssz.Marshaler, error) {
 			Balances:   balances,
 			Slashings:  slashings,
 		}
+	} else if input.Fork == proto.Fork_Merge {
+		if input.ExecutionPayloadHeader == nil {
+			return nil, fmt.Errorf("merge genesis requires an execution payload header")
+		}
+
+		bodyRoot, err := preset.emptyBodyRoot(input.Fork)
+		if err != nil {
+			return nil, err
+		}
+
+		state = &consensus.BeaconStateBellatrix{
+			GenesisTime:           uint64(input.GenesisTime),
+			GenesisValidatorsRoot: genesisValidatorRoot,
+			Fork:                  fork,
+			LatestBlockHeader: &consensus.BeaconBlockHeader{
+				BodyRoot: bodyRoot,
+			},
+			Eth1Data: &consensus.Eth1Data{
+				DepositRoot: emptyDepositRoot,
+				BlockHash:   input.Eth1Block.Hash,
+			},
+			Validators:                   validators,
+			Balances:                     balances,
+			Slashings:                    slashings,
+			LatestExecutionPayloadHeader: input.ExecutionPayloadHeader,
+		}
+	} else {
+		return nil, fmt.Errorf("fork %s not supported", input.Fork)
 	}
 
+	if preset != MainnetPreset {
+		state = &presetState{preset: preset, state: state}
+	}
 	return state, nil
 }
 
@@ -1,10 +1,16 @@
 package genesis
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
+	consensus "github.com/umbracle/go-eth-consensus"
 	"github.com/umbracle/viewpoint/internal/server/proto"
 )
 
@@ -23,3 +29,221 @@ func TestGenesis(t *testing.T) {
 	_, err = state.MarshalSSZ()
 	assert.NoError(t, err)
 }
+
+func TestGenesis_Merge(t *testing.T) {
+	accounts := proto.NewAccounts(10)
+
+	var block ExecutionBlock
+	require.NoError(t, json.Unmarshal([]byte(gethGenesisBlock), &block))
+
+	header, err := block.PayloadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash, ethgo.Hash(header.BlockHash))
+	assert.Equal(t, uint64(0x1c9c380), header.GasLimit)
+	// the base fee (1 gwei) is encoded in little endian
+	assert.Equal(t, []byte{0x00, 0xca, 0x9a, 0x3b}, header.BaseFeePerGas[:4])
+
+	input := &Input{
+		Eth1Block:        &ethgo.Block{Hash: block.Hash},
+		GenesisTime:      10000,
+		InitialValidator: accounts,
+		Fork:             proto.Fork_Merge,
+	}
+
+	// the execution payload header is required
+	_, err = GenerateGenesis(input)
+	assert.Error(t, err)
+
+	input.ExecutionPayloadHeader = header
+	state, err := GenerateGenesis(input)
+	require.NoError(t, err)
+
+	bellatrix, ok := state.(*consensus.BeaconStateBellatrix)
+	require.True(t, ok)
+	assert.Equal(t, header, bellatrix.LatestExecutionPayloadHeader)
+
+	_, err = state.MarshalSSZ()
+	assert.NoError(t, err)
+}
+
+func TestGenesis_EmptyTransactionsRoot(t *testing.T) {
+	root, err := emptyTransactionsRoot()
+	require.NoError(t, err)
+	assert.Equal(t, "7ffe241ea60187fdb0187bfa22de35d1f9bed7ab061d9401fd47e34a54fbede1", hex.EncodeToString(root[:]))
+}
+
+// gethGenesisBlock is the genesis block of a post-merge geth chain
+// returned by eth_getBlockByNumber
+var gethGenesisBlock = `{
+	"baseFeePerGas": "0x3b9aca00",
+	"difficulty": "0x1",
+	"extraData": "0x",
+	"gasLimit": "0x1c9c380",
+	"gasUsed": "0x0",
+	"hash": "0x5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a5a1a",
+	"logsBloom": "0x` + strings.Repeat("00", 256) + `",
+	"miner": "0x0000000000000000000000000000000000000000",
+	"mixHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	"nonce": "0x0000000000000042",
+	"number": "0x0",
+	"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	"receiptsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	"stateRoot": "0x7c2ff4d0b3c5dfd7d2c0ef0a4e7a9e2b3e2b8d42d0d2f8a8f7d2e8c1f2a3b4c5",
+	"timestamp": "0x0",
+	"transactions": [],
+	"uncles": []
+}`
+
+func TestGenesis_Preset(t *testing.T) {
+	accounts := proto.NewAccounts(4)
+
+	var block ExecutionBlock
+	require.NoError(t, json.Unmarshal([]byte(gethGenesisBlock), &block))
+	header, err := block.PayloadHeader()
+	require.NoError(t, err)
+
+	forks := []proto.Fork{proto.Fork_Phase0, proto.Fork_Altair, proto.Fork_Merge}
+	for _, fork := range forks {
+		input := &Input{
+			Eth1Block:              &ethgo.Block{Hash: block.Hash},
+			GenesisTime:            10000,
+			InitialValidator:       accounts,
+			Fork:                   fork,
+			ExecutionPayloadHeader: header,
+		}
+		state, err := GenerateGenesis(input)
+		require.NoError(t, err)
+
+		expected, err := state.MarshalSSZ()
+		require.NoError(t, err)
+
+		// the encoding with the mainnet preset is the same one as go-eth-consensus
+		mainnet, err := (&presetState{preset: MainnetPreset, state: state}).MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, expected, mainnet, fork.String())
+
+		// the minimal preset reduces the size of the vectors
+		input.Preset = MinimalPreset
+		minimalState, err := GenerateGenesis(input)
+		require.NoError(t, err)
+
+		minimal, err := minimalState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, minimalState.SizeSSZ(), len(minimal))
+
+		diff := (MainnetPreset.SlotsPerHistoricalRoot-MinimalPreset.SlotsPerHistoricalRoot)*2*32 +
+			(MainnetPreset.EpochsPerHistoricalVector-MinimalPreset.EpochsPerHistoricalVector)*32 +
+			(MainnetPreset.EpochsPerSlashingsVector-MinimalPreset.EpochsPerSlashingsVector)*8
+		if fork != proto.Fork_Phase0 {
+			// current and next sync committees
+			diff += (MainnetPreset.SyncCommitteeSize - MinimalPreset.SyncCommitteeSize) * 2 * 48
+		}
+		assert.Equal(t, len(expected)-diff, len(minimal), fork.String())
+
+		// the header of the genesis block uses the sync aggregate of the preset
+		assert.Equal(t, testEmptyBodyRoot(t, fork, MinimalPreset), latestBodyRoot(minimalState.(*presetState).state), fork.String())
+		assert.Equal(t, testEmptyBodyRoot(t, fork, MainnetPreset), latestBodyRoot(state), fork.String())
+	}
+}
+
+func TestGenesis_EmptyBodyRoot(t *testing.T) {
+	// the root of the mainnet preset is the same one as go-eth-consensus
+	bodies := map[proto.Fork]interface {
+		HashTreeRoot() ([32]byte, error)
+	}{
+		proto.Fork_Phase0: &consensus.BeaconBlockBodyPhase0{
+			Eth1Data: &consensus.Eth1Data{},
+		},
+		proto.Fork_Altair: &consensus.BeaconBlockBodyAltair{
+			Eth1Data:      &consensus.Eth1Data{},
+			SyncAggregate: &consensus.SyncAggregate{},
+		},
+		proto.Fork_Merge: &consensus.BeaconBlockBodyBellatrix{
+			Eth1Data:         &consensus.Eth1Data{},
+			SyncAggregate:    &consensus.SyncAggregate{},
+			ExecutionPayload: &consensus.ExecutionPayload{},
+		},
+	}
+	for fork, body := range bodies {
+		expected, err := body.HashTreeRoot()
+		require.NoError(t, err)
+
+		root, err := MainnetPreset.emptyBodyRoot(fork)
+		require.NoError(t, err)
+		assert.Equal(t, expected, root, fork.String())
+		assert.Equal(t, expected, testEmptyBodyRoot(t, fork, MainnetPreset), fork.String())
+
+		root, err = MinimalPreset.emptyBodyRoot(fork)
+		require.NoError(t, err)
+		assert.Equal(t, testEmptyBodyRoot(t, fork, MinimalPreset), root, fork.String())
+
+		if fork == proto.Fork_Phase0 {
+			// there is no sync aggregate before altair
+			assert.Equal(t, expected, root)
+		} else {
+			assert.NotEqual(t, expected, root, fork.String())
+		}
+	}
+}
+
+func latestBodyRoot(state interface{}) [32]byte {
+	switch obj := state.(type) {
+	case *consensus.BeaconStatePhase0:
+		return obj.LatestBlockHeader.BodyRoot
+	case *consensus.BeaconStateAltair:
+		return obj.LatestBlockHeader.BodyRoot
+	case *consensus.BeaconStateBellatrix:
+		return obj.LatestBlockHeader.BodyRoot
+	}
+	return [32]byte{}
+}
+
+// testEmptyBodyRoot merkleizes the fields of an empty block body as in the spec
+func testEmptyBodyRoot(t *testing.T, fork proto.Fork, preset *Preset) [32]byte {
+	hash := func(a, b [32]byte) [32]byte {
+		return sha256.Sum256(append(a[:], b[:]...))
+	}
+	zeroHashes := [][32]byte{{}}
+	for i := 1; i < 8; i++ {
+		zeroHashes = append(zeroHashes, hash(zeroHashes[i-1], zeroHashes[i-1]))
+	}
+	merkleize := func(leaves [][32]byte) [32]byte {
+		for len(leaves)&(len(leaves)-1) != 0 {
+			leaves = append(leaves, [32]byte{})
+		}
+		for len(leaves) > 1 {
+			next := [][32]byte{}
+			for i := 0; i < len(leaves); i += 2 {
+				next = append(next, hash(leaves[i], leaves[i+1]))
+			}
+			leaves = next
+		}
+		return leaves[0]
+	}
+	emptyList := func(depth int) [32]byte {
+		// the length of the list is mixed in with the root
+		return hash(zeroHashes[depth], [32]byte{})
+	}
+
+	fields := [][32]byte{
+		zeroHashes[2], // randao reveal (96 bytes)
+		zeroHashes[2], // eth1 data
+		{},            // graffiti
+		emptyList(4),  // proposer slashings (16)
+		emptyList(1),  // attester slashings (2)
+		emptyList(7),  // attestations (128)
+		emptyList(4),  // deposits (16)
+		emptyList(4),  // voluntary exits (16)
+	}
+	if fork != proto.Fork_Phase0 {
+		// the bits of the sync committee are packed in chunks of 256 bits
+		chunks := make([][32]byte, (preset.SyncCommitteeSize+255)/256)
+		fields = append(fields, hash(merkleize(chunks), zeroHashes[2]))
+	}
+	if fork == proto.Fork_Merge {
+		payloadRoot, err := new(consensus.ExecutionPayload).HashTreeRoot()
+		require.NoError(t, err)
+		fields = append(fields, payloadRoot)
+	}
+	return merkleize(fields)
+}
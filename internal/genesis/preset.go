@@ -0,0 +1,337 @@
+package genesis
+
+import (
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// Preset are the constants of the spec that set the size of the vectors
+// of the beacon state. The types of go-eth-consensus use the mainnet preset.
+type Preset struct {
+	Name                      string
+	SlotsPerHistoricalRoot    int
+	EpochsPerHistoricalVector int
+	EpochsPerSlashingsVector  int
+	SyncCommitteeSize         int
+}
+
+var (
+	// MainnetPreset is the preset of the mainnet network
+	MainnetPreset = &Preset{
+		Name:                      "mainnet",
+		SlotsPerHistoricalRoot:    8192,
+		EpochsPerHistoricalVector: 65536,
+		EpochsPerSlashingsVector:  8192,
+		SyncCommitteeSize:         512,
+	}
+
+	// MinimalPreset is the preset of the minimal networks used for testing
+	MinimalPreset = &Preset{
+		Name:                      "minimal",
+		SlotsPerHistoricalRoot:    64,
+		EpochsPerHistoricalVector: 64,
+		EpochsPerSlashingsVector:  64,
+		SyncCommitteeSize:         32,
+	}
+)
+
+// PresetByName returns the preset with the given name
+func PresetByName(name string) (*Preset, bool) {
+	switch name {
+	case MainnetPreset.Name:
+		return MainnetPreset, true
+	case MinimalPreset.Name:
+		return MinimalPreset, true
+	default:
+		return nil, false
+	}
+}
+
+// emptyBodyRoot returns the root of the empty block body of the genesis block header.
+// The sync aggregate of the body has a bit for each member of the sync committee, so
+// the types of go-eth-consensus can only hash the body of the mainnet preset.
+func (p *Preset) emptyBodyRoot(fork proto.Fork) ([32]byte, error) {
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+
+	// randao reveal, eth1 data and graffiti
+	hh.PutBytes(make([]byte, 96))
+	if err := new(consensus.Eth1Data).HashTreeRootWith(hh); err != nil {
+		return [32]byte{}, err
+	}
+	hh.PutBytes(make([]byte, 32))
+
+	// proposer slashings, attester slashings, attestations, deposits
+	// and voluntary exits. Their limits are the same in all the presets.
+	for _, limit := range []uint64{16, 2, 128, 16, 16} {
+		subIndx := hh.Index()
+		hh.MerkleizeWithMixin(subIndx, 0, limit)
+	}
+
+	if fork != proto.Fork_Phase0 {
+		// sync aggregate
+		subIndx := hh.Index()
+		hh.PutBytes(make([]byte, p.SyncCommitteeSize/8))
+		hh.PutBytes(make([]byte, 96))
+		hh.Merkleize(subIndx)
+	}
+	if fork == proto.Fork_Merge {
+		if err := new(consensus.ExecutionPayload).HashTreeRootWith(hh); err != nil {
+			return [32]byte{}, err
+		}
+	}
+
+	hh.Merkleize(indx)
+	return hh.HashRoot()
+}
+
+// presetState is a beacon state encoded with the vector sizes of a preset.
+// The vectors of the state are truncated to the sizes of the preset.
+type presetState struct {
+	preset *Preset
+	state  ssz.Marshaler
+}
+
+// MarshalSSZ implements the ssz.Marshaler interface
+func (p *presetState) MarshalSSZ() ([]byte, error) {
+	return p.MarshalSSZTo(nil)
+}
+
+// SizeSSZ implements the ssz.Marshaler interface
+func (p *presetState) SizeSSZ() int {
+	buf, err := p.MarshalSSZ()
+	if err != nil {
+		return 0
+	}
+	return len(buf)
+}
+
+// MarshalSSZTo implements the ssz.Marshaler interface
+func (p *presetState) MarshalSSZTo(dst []byte) ([]byte, error) {
+	enc := &stateEncoder{}
+
+	switch obj := p.state.(type) {
+	case *consensus.BeaconStatePhase0:
+		p.encodeHeader(enc, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.LatestBlockHeader, obj.BlockRoots[:], obj.StateRoots[:], obj.HistoricalRoots)
+		p.encodeEth1(enc, obj.Eth1Data, obj.Eth1DataVotes, obj.Eth1DepositIndex, obj.Validators, obj.Balances, obj.RandaoMixes[:], obj.Slashings)
+		if err := encodeAttestations(enc, obj.PreviousEpochAttestations); err != nil {
+			return nil, err
+		}
+		if err := encodeAttestations(enc, obj.CurrentEpochAttestations); err != nil {
+			return nil, err
+		}
+		p.encodeCheckpoints(enc, obj.JustificationBits, obj.PreviousJustifiedCheckpoint, obj.CurrentJustifiedCheckpoint, obj.FinalizedCheckpoint)
+
+	case *consensus.BeaconStateAltair:
+		p.encodeHeader(enc, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.LatestBlockHeader, obj.BlockRoots[:], obj.StateRoots[:], obj.HistoricalRoots)
+		p.encodeEth1(enc, obj.Eth1Data, obj.Eth1DataVotes, obj.Eth1DepositIndex, obj.Validators, obj.Balances, obj.RandaoMixes[:], obj.Slashings)
+		enc.variable(obj.PreviousEpochParticipation)
+		enc.variable(obj.CurrentEpochParticipation)
+		p.encodeCheckpoints(enc, obj.JustificationBits, obj.PreviousJustifiedCheckpoint, obj.CurrentJustifiedCheckpoint, obj.FinalizedCheckpoint)
+		enc.variable(marshalUint64s(obj.InactivityScores))
+		p.encodeSyncCommittee(enc, obj.CurrentSyncCommittee)
+		p.encodeSyncCommittee(enc, obj.NextSyncCommittee)
+
+	case *consensus.BeaconStateBellatrix:
+		historicalRoots := make([][32]byte, len(obj.HistoricalRoots))
+		for i, root := range obj.HistoricalRoots {
+			if len(root) != 32 {
+				return nil, fmt.Errorf("historical root %d of %d bytes, expected 32", i, len(root))
+			}
+			copy(historicalRoots[i][:], root)
+		}
+		p.encodeHeader(enc, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.LatestBlockHeader, obj.BlockRoots[:], obj.StateRoots[:], historicalRoots)
+		p.encodeEth1(enc, obj.Eth1Data, obj.Eth1DataVotes, obj.Eth1DepositIndex, obj.Validators, obj.Balances, obj.RandaoMixes[:], obj.Slashings)
+		enc.variable(obj.PreviousEpochParticipation)
+		enc.variable(obj.CurrentEpochParticipation)
+		p.encodeCheckpoints(enc, obj.JustificationBits, obj.PreviousJustifiedCheckpoint, obj.CurrentJustifiedCheckpoint, obj.FinalizedCheckpoint)
+		enc.variable(marshalUint64s(obj.InactivityScores))
+		p.encodeSyncCommittee(enc, obj.CurrentSyncCommittee)
+		p.encodeSyncCommittee(enc, obj.NextSyncCommittee)
+
+		header := obj.LatestExecutionPayloadHeader
+		if header == nil {
+			header = new(consensus.ExecutionPayloadHeader)
+		}
+		buf, err := header.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		enc.variable(buf)
+
+	default:
+		return nil, fmt.Errorf("state %T not supported", p.state)
+	}
+
+	if err := enc.err; err != nil {
+		return nil, err
+	}
+	return enc.encode(dst), nil
+}
+
+func (p *presetState) encodeHeader(enc *stateEncoder, genesisTime uint64, genesisValidatorsRoot [32]byte, slot uint64, fork *consensus.Fork, header *consensus.BeaconBlockHeader, blockRoots, stateRoots, historicalRoots [][32]byte) {
+	enc.fixed(ssz.MarshalUint64(nil, genesisTime))
+	enc.fixed(genesisValidatorsRoot[:])
+	enc.fixed(ssz.MarshalUint64(nil, slot))
+
+	if fork == nil {
+		fork = new(consensus.Fork)
+	}
+	enc.marshal(fork)
+	if header == nil {
+		header = new(consensus.BeaconBlockHeader)
+	}
+	enc.marshal(header)
+
+	enc.fixed(marshalRoots(blockRoots[:p.preset.SlotsPerHistoricalRoot]))
+	enc.fixed(marshalRoots(stateRoots[:p.preset.SlotsPerHistoricalRoot]))
+	enc.variable(marshalRoots(historicalRoots))
+}
+
+func (p *presetState) encodeEth1(enc *stateEncoder, eth1Data *consensus.Eth1Data, votes []*consensus.Eth1Data, depositIndex uint64, validators []*consensus.Validator, balances []uint64, randaoMixes [][32]byte, slashings []uint64) {
+	if eth1Data == nil {
+		eth1Data = new(consensus.Eth1Data)
+	}
+	enc.marshal(eth1Data)
+
+	var buf []byte
+	for _, vote := range votes {
+		buf = enc.marshalTo(buf, vote)
+	}
+	enc.variable(buf)
+	enc.fixed(ssz.MarshalUint64(nil, depositIndex))
+
+	buf = nil
+	for _, val := range validators {
+		buf = enc.marshalTo(buf, val)
+	}
+	enc.variable(buf)
+	enc.variable(marshalUint64s(balances))
+
+	enc.fixed(marshalRoots(randaoMixes[:p.preset.EpochsPerHistoricalVector]))
+
+	// the slashings of the state are set to the size of the mainnet preset
+	if len(slashings) < p.preset.EpochsPerSlashingsVector {
+		enc.err = fmt.Errorf("expected %d slashings but found %d", p.preset.EpochsPerSlashingsVector, len(slashings))
+		return
+	}
+	enc.fixed(marshalUint64s(slashings[:p.preset.EpochsPerSlashingsVector]))
+}
+
+func (p *presetState) encodeCheckpoints(enc *stateEncoder, justificationBits [1]byte, checkpoints ...*consensus.Checkpoint) {
+	enc.fixed(justificationBits[:])
+	for _, checkpoint := range checkpoints {
+		if checkpoint == nil {
+			checkpoint = new(consensus.Checkpoint)
+		}
+		enc.marshal(checkpoint)
+	}
+}
+
+func (p *presetState) encodeSyncCommittee(enc *stateEncoder, committee *consensus.SyncCommittee) {
+	if committee == nil {
+		committee = new(consensus.SyncCommittee)
+	}
+	var buf []byte
+	for _, pub := range committee.PubKeys[:p.preset.SyncCommitteeSize] {
+		buf = append(buf, pub[:]...)
+	}
+	buf = append(buf, committee.AggregatePubKey[:]...)
+	enc.fixed(buf)
+}
+
+// encodeAttestations encodes a list of pending attestations. The attestations
+// have a variable size and each one is referenced with an offset.
+func encodeAttestations(enc *stateEncoder, attestations []*consensus.PendingAttestation) error {
+	list := &stateEncoder{}
+	for _, att := range attestations {
+		buf, err := att.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		list.variable(buf)
+	}
+	enc.variable(list.encode(nil))
+	return nil
+}
+
+// stateEncoder builds an ssz container with fixed and variable size fields.
+// The variable fields are written after the fixed part and referenced with
+// an offset in the position of the field.
+type stateEncoder struct {
+	parts []encoderPart
+	err   error
+}
+
+type encoderPart struct {
+	buf      []byte
+	variable bool
+}
+
+func (e *stateEncoder) fixed(buf []byte) {
+	e.parts = append(e.parts, encoderPart{buf: buf})
+}
+
+func (e *stateEncoder) variable(buf []byte) {
+	e.parts = append(e.parts, encoderPart{buf: buf, variable: true})
+}
+
+func (e *stateEncoder) marshal(obj ssz.Marshaler) {
+	e.fixed(e.marshalTo(nil, obj))
+}
+
+func (e *stateEncoder) marshalTo(dst []byte, obj ssz.Marshaler) []byte {
+	if e.err != nil {
+		return dst
+	}
+	var err error
+	if dst, err = obj.MarshalSSZTo(dst); err != nil {
+		e.err = err
+	}
+	return dst
+}
+
+func (e *stateEncoder) encode(dst []byte) []byte {
+	offset := 0
+	for _, part := range e.parts {
+		if part.variable {
+			offset += 4
+		} else {
+			offset += len(part.buf)
+		}
+	}
+
+	for _, part := range e.parts {
+		if part.variable {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(part.buf)
+		} else {
+			dst = append(dst, part.buf...)
+		}
+	}
+	for _, part := range e.parts {
+		if part.variable {
+			dst = append(dst, part.buf...)
+		}
+	}
+	return dst
+}
+
+func marshalRoots(roots [][32]byte) []byte {
+	buf := make([]byte, 0, len(roots)*32)
+	for _, root := range roots {
+		buf = append(buf, root[:]...)
+	}
+	return buf
+}
+
+func marshalUint64s(nums []uint64) []byte {
+	buf := make([]byte, 0, len(nums)*8)
+	for _, num := range nums {
+		buf = ssz.MarshalUint64(buf, num)
+	}
+	return buf
+}
@@ -0,0 +1,427 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/server"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest describes a whole network: the config of the server, the
+// tranches and the beacon and validator nodes.
+type Manifest struct {
+	// Name is the name of the network
+	Name string `yaml:"name"`
+
+	// Mnemonic derives the keys of the validators deterministically.
+	// Seed is an hex encoded seed used instead of the mnemonic.
+	Mnemonic string `yaml:"mnemonic"`
+	Seed     string `yaml:"seed"`
+
+	// Genesis is the config of the genesis of the network
+	Genesis *Genesis `yaml:"genesis"`
+
+	// Spec overrides the default values of the Eth2 spec
+	Spec *Spec `yaml:"spec"`
+
+	// Tranches are the tranches created (and deposited) after genesis
+	Tranches []*Tranche `yaml:"tranches"`
+
+	// Nodes are the beacon and validator nodes of the network
+	Nodes []*Node `yaml:"nodes"`
+}
+
+// Genesis is the config of the genesis of the network
+type Genesis struct {
+	// Time is the amount of time from now when the genesis starts
+	Time string `yaml:"time"`
+
+	// Validators is the number of active validators at genesis
+	Validators uint64 `yaml:"validators"`
+
+	// Tranches is the number of tranches of the genesis validators
+	Tranches uint64 `yaml:"tranches"`
+}
+
+// Spec is the set of Eth2 spec values that can be set in a manifest
+type Spec struct {
+	// Preset is the preset of the network (mainnet or minimal). The values
+	// of the preset are set before the other values of the spec.
+	Preset string `yaml:"preset"`
+
+	MinGenesisValidatorCount  *int `yaml:"min_genesis_validator_count"`
+	GenesisDelay              *int `yaml:"genesis_delay"`
+	EthFollowDistance         *int `yaml:"eth1_follow_distance"`
+	SecondsPerEth1Block       *int `yaml:"seconds_per_eth1_block"`
+	EpochsPerEth1VotingPeriod *int `yaml:"epochs_per_eth1_voting_period"`
+	ShardCommitteePeriod      *int `yaml:"shard_committee_period"`
+	SlotsPerEpoch             *int `yaml:"slots_per_epoch"`
+	SecondsPerSlot            *int `yaml:"seconds_per_slot"`
+	Altair                    *int `yaml:"altair"`
+	Bellatrix                 *int `yaml:"bellatrix"`
+
+	GenesisForkVersion   *server.ForkVersion `yaml:"genesis_fork_version"`
+	AltairForkVersion    *server.ForkVersion `yaml:"altair_fork_version"`
+	BellatrixForkVersion *server.ForkVersion `yaml:"bellatrix_fork_version"`
+
+	MinValidatorWithdrawabilityDelay *int    `yaml:"min_validator_withdrawability_delay"`
+	EjectionBalance                  *uint64 `yaml:"ejection_balance"`
+	MinPerEpochChurnLimit            *int    `yaml:"min_per_epoch_churn_limit"`
+	ChurnLimitQuotient               *int    `yaml:"churn_limit_quotient"`
+	InactivityScoreBias              *int    `yaml:"inactivity_score_bias"`
+	InactivityScoreRecoveryRate      *int    `yaml:"inactivity_score_recovery_rate"`
+	ProposerScoreBoost               *int    `yaml:"proposer_score_boost"`
+}
+
+// Tranche is a tranche of validators deposited after genesis
+type Tranche struct {
+	Validators uint64 `yaml:"validators"`
+}
+
+const (
+	nodeTypeBeacon    = "beacon"
+	nodeTypeValidator = "validator"
+)
+
+// Node is a group of nodes of the same type and client
+type Node struct {
+	// Name is used to reference the node from other nodes of the manifest
+	Name string `yaml:"name"`
+
+	// Type is the type of the node (beacon or validator)
+	Type string `yaml:"type"`
+
+	// Client is the client of the node (i.e. lighthouse)
+	Client string `yaml:"client"`
+
+	// Repo and Tag override the default container of the client
+	Repo string `yaml:"repo"`
+	Tag  string `yaml:"tag"`
+
+	// Count is the number of beacon nodes to deploy
+	Count uint64 `yaml:"count"`
+
+	// Tranche is the index of the tranche used by a validator
+	Tranche *uint64 `yaml:"tranche"`
+
+	// Validators creates a new tranche (with deposits) for a validator
+	Validators uint64 `yaml:"validators"`
+
+	// Beacon is the name in the manifest of the beacon nodes a validator
+	// connects to
+	Beacon string `yaml:"beacon"`
+
+	// Restart is the restart policy of the nodes (never, on-failure or always)
+	Restart string `yaml:"restart"`
+
+	// MaxRetries limits the number of restarts with the on-failure policy
+	MaxRetries uint64 `yaml:"max_retries"`
+}
+
+// ReadFile reads and validates a manifest file
+func ReadFile(path string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a manifest
+func Parse(data []byte) (*Manifest, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var m Manifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %v", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (m *Manifest) numGenesisTranches() uint64 {
+	if m.Genesis == nil || m.Genesis.Tranches == 0 {
+		return 1
+	}
+	return m.Genesis.Tranches
+}
+
+// Validate checks that the manifest is valid
+func (m *Manifest) Validate() error {
+	if genesis := m.Genesis; genesis != nil {
+		if genesis.Time != "" {
+			if _, err := time.ParseDuration(genesis.Time); err != nil {
+				return fmt.Errorf("failed to parse genesis time: %v", err)
+			}
+		}
+		validators := genesis.Validators
+		if validators == 0 {
+			validators = server.DefaultConfig().NumGenesisValidators
+		}
+		if validators%m.numGenesisTranches() != 0 {
+			return fmt.Errorf("genesis validators must be a multiple of the number of tranches")
+		}
+	}
+	for indx, tranche := range m.Tranches {
+		if tranche.Validators == 0 {
+			return fmt.Errorf("tranche %d has no validators", indx)
+		}
+	}
+
+	numTranches := m.numGenesisTranches() + uint64(len(m.Tranches))
+	usedTranches := map[uint64]string{}
+
+	// beacon nodes that can be referenced by the validators
+	beacons := map[string]struct{}{}
+	names := map[string]struct{}{}
+
+	for _, node := range m.Nodes {
+		if node.Name == "" {
+			continue
+		}
+		if _, ok := names[node.Name]; ok {
+			return fmt.Errorf("node '%s' is duplicated", node.Name)
+		}
+		names[node.Name] = struct{}{}
+
+		if strings.ToLower(node.Type) == nodeTypeBeacon {
+			beacons[node.Name] = struct{}{}
+		}
+	}
+
+	for indx, node := range m.Nodes {
+		ref := node.Name
+		if ref == "" {
+			ref = fmt.Sprintf("#%d", indx)
+		}
+
+		if _, ok := proto.StringToNodeClient(node.Client); !ok {
+			return fmt.Errorf("node %s: client '%s' not found", ref, node.Client)
+		}
+		if node.Restart != "" {
+			if _, ok := proto.StringToRestartPolicy(node.Restart); !ok {
+				return fmt.Errorf("node %s: restart policy '%s' not found", ref, node.Restart)
+			}
+		}
+
+		switch strings.ToLower(node.Type) {
+		case nodeTypeBeacon:
+			if node.Tranche != nil || node.Validators != 0 || node.Beacon != "" {
+				return fmt.Errorf("node %s: beacon nodes cannot set tranche, validators or beacon", ref)
+			}
+
+		case nodeTypeValidator:
+			if node.Count != 0 {
+				return fmt.Errorf("node %s: validator nodes cannot set count", ref)
+			}
+			if node.Tranche != nil && node.Validators != 0 {
+				return fmt.Errorf("node %s: tranche and validators cannot be set at the same time", ref)
+			}
+			if node.Tranche == nil && node.Validators == 0 {
+				return fmt.Errorf("node %s: either tranche or validators must be set", ref)
+			}
+			if node.Tranche != nil {
+				tranche := *node.Tranche
+				if tranche >= numTranches {
+					return fmt.Errorf("node %s: tranche %d does not exists", ref, tranche)
+				}
+				if other, ok := usedTranches[tranche]; ok {
+					return fmt.Errorf("node %s: tranche %d is already used by node %s", ref, tranche, other)
+				}
+				usedTranches[tranche] = ref
+			}
+			if node.Beacon != "" {
+				if _, ok := beacons[node.Beacon]; !ok {
+					return fmt.Errorf("node %s: beacon '%s' not found", ref, node.Beacon)
+				}
+			}
+
+		default:
+			return fmt.Errorf("node %s: type '%s' not found", ref, node.Type)
+		}
+	}
+
+	// validate the spec with the overrides of the manifest
+	config, err := m.Config()
+	if err != nil {
+		return err
+	}
+	if err := config.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid spec: %v", err)
+	}
+	if _, err := config.KeySeed(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Config returns the config of the server for the manifest
+func (m *Manifest) Config() (*server.Config, error) {
+	config := server.DefaultConfig()
+	if m.Name != "" {
+		config.Name = m.Name
+	}
+	config.Mnemonic = m.Mnemonic
+	config.Seed = m.Seed
+
+	genesisTime := "1m"
+	if genesis := m.Genesis; genesis != nil {
+		if genesis.Time != "" {
+			genesisTime = genesis.Time
+		}
+		if genesis.Validators != 0 {
+			config.NumGenesisValidators = genesis.Validators
+			// by default all the genesis validators are required to start the chain
+			config.Spec.MinGenesisValidatorCount = int(genesis.Validators)
+		}
+	}
+	config.NumTranches = m.numGenesisTranches()
+
+	duration, err := time.ParseDuration(genesisTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse genesis time: %v", err)
+	}
+	config.Spec.MinGenesisTime = int(time.Now().Add(duration).Unix())
+
+	if spec := m.Spec; spec != nil {
+		if spec.Preset != "" {
+			if err := config.Spec.ApplyPreset(spec.Preset); err != nil {
+				return nil, err
+			}
+		}
+		setInt := func(dst *int, src *int) {
+			if src != nil {
+				*dst = *src
+			}
+		}
+		setInt(&config.Spec.MinGenesisValidatorCount, spec.MinGenesisValidatorCount)
+		setInt(&config.Spec.GenesisDelay, spec.GenesisDelay)
+		setInt(&config.Spec.EthFollowDistance, spec.EthFollowDistance)
+		setInt(&config.Spec.SecondsPerEth1Block, spec.SecondsPerEth1Block)
+		setInt(&config.Spec.EpochsPerEth1VotingPeriod, spec.EpochsPerEth1VotingPeriod)
+		setInt(&config.Spec.ShardCommitteePeriod, spec.ShardCommitteePeriod)
+		setInt(&config.Spec.SlotsPerEpoch, spec.SlotsPerEpoch)
+		setInt(&config.Spec.SecondsPerSlot, spec.SecondsPerSlot)
+		setInt(&config.Spec.MinValidatorWithdrawabilityDelay, spec.MinValidatorWithdrawabilityDelay)
+		setInt(&config.Spec.MinPerEpochChurnLimit, spec.MinPerEpochChurnLimit)
+		setInt(&config.Spec.ChurnLimitQuotient, spec.ChurnLimitQuotient)
+		setInt(&config.Spec.InactivityScoreBias, spec.InactivityScoreBias)
+		setInt(&config.Spec.InactivityScoreRecoveryRate, spec.InactivityScoreRecoveryRate)
+		setInt(&config.Spec.ProposerScoreBoost, spec.ProposerScoreBoost)
+
+		if spec.EjectionBalance != nil {
+			config.Spec.EjectionBalance = *spec.EjectionBalance
+		}
+		setVersion := func(dst *server.ForkVersion, src *server.ForkVersion) {
+			if src != nil {
+				*dst = *src
+			}
+		}
+		setVersion(&config.Spec.GenesisForkVersion, spec.GenesisForkVersion)
+		setVersion(&config.Spec.AltairForkVersion, spec.AltairForkVersion)
+		setVersion(&config.Spec.BellatrixForkVersion, spec.BellatrixForkVersion)
+
+		config.Spec.Altair = spec.Altair
+		config.Spec.Bellatrix = spec.Bellatrix
+	}
+	return config, nil
+}
+
+// Deployer is the part of the E2EService used to apply a manifest
+type Deployer interface {
+	DepositCreate(context.Context, *proto.DepositCreateRequest) (*proto.DepositCreateResponse, error)
+	NodeDeploy(context.Context, *proto.NodeDeployRequest) (*proto.NodeDeployResponse, error)
+}
+
+// Apply creates the tranches and deploys the nodes of the manifest. The beacon
+// nodes are deployed first since the validators might connect to them.
+func (m *Manifest) Apply(ctx context.Context, deployer Deployer) ([]*proto.Node, error) {
+	for _, tranche := range m.Tranches {
+		req := &proto.DepositCreateRequest{
+			NumValidators: tranche.Validators,
+		}
+		if _, err := deployer.DepositCreate(ctx, req); err != nil {
+			return nil, fmt.Errorf("failed to create tranche: %v", err)
+		}
+	}
+
+	nodes := []*proto.Node{}
+
+	// name of the first beacon node deployed for each manifest node
+	beacons := map[string]string{}
+
+	deploy := func(node *Node, req *proto.NodeDeployRequest) ([]*proto.Node, error) {
+		client, _ := proto.StringToNodeClient(node.Client)
+
+		req.NodeClient = client
+		req.Repo = node.Repo
+		req.Tag = node.Tag
+
+		if node.Restart != "" {
+			req.RestartPolicy, _ = proto.StringToRestartPolicy(node.Restart)
+		}
+		req.MaxRetries = node.MaxRetries
+
+		resp, err := deployer.NodeDeploy(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deploy %s %s: %v", node.Client, node.Type, err)
+		}
+		nodes = append(nodes, resp.Nodes...)
+		return resp.Nodes, nil
+	}
+
+	for _, node := range m.Nodes {
+		if strings.ToLower(node.Type) != nodeTypeBeacon {
+			continue
+		}
+		count := node.Count
+		if count == 0 {
+			count = 1
+		}
+		req := &proto.NodeDeployRequest{
+			NodeType: &proto.NodeDeployRequest_Beacon_{
+				Beacon: &proto.NodeDeployRequest_Beacon{
+					Count: count,
+				},
+			},
+		}
+		created, err := deploy(node, req)
+		if err != nil {
+			return nil, err
+		}
+		if node.Name != "" && len(created) != 0 {
+			beacons[node.Name] = created[0].Name
+		}
+	}
+
+	for _, node := range m.Nodes {
+		if strings.ToLower(node.Type) != nodeTypeValidator {
+			continue
+		}
+		validator := &proto.NodeDeployRequest_Validator{
+			NumValidators: node.Validators,
+			Beacon:        beacons[node.Beacon],
+		}
+		if node.Tranche != nil {
+			validator.NumTranch = *node.Tranche
+		}
+		req := &proto.NodeDeployRequest{
+			NodeType: &proto.NodeDeployRequest_Validator_{
+				Validator: validator,
+			},
+		}
+		if _, err := deploy(node, req); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,203 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+var testManifest = `
+name: mixed
+genesis:
+  time: 30s
+  validators: 20
+  tranches: 2
+spec:
+  slots_per_epoch: 8
+  altair: 0
+  altair_fork_version: "0x01000000"
+  ejection_balance: 20000000000
+tranches:
+  - validators: 4
+nodes:
+  - name: lh
+    type: validator
+    client: lighthouse
+    tranche: 0
+    beacon: lh-beacon
+  - name: lh-beacon
+    type: beacon
+    client: lighthouse
+    count: 2
+  - type: beacon
+    client: teku
+    tag: custom
+    restart: on-failure
+    max_retries: 3
+  - type: validator
+    client: teku
+    tranche: 2
+`
+
+func TestManifest_Config(t *testing.T) {
+	m, err := Parse([]byte(testManifest))
+	require.NoError(t, err)
+
+	config, err := m.Config()
+	require.NoError(t, err)
+
+	assert.Equal(t, "mixed", config.Name)
+	assert.Equal(t, uint64(20), config.NumGenesisValidators)
+	assert.Equal(t, uint64(2), config.NumTranches)
+	assert.Equal(t, 20, config.Spec.MinGenesisValidatorCount)
+	assert.Equal(t, 8, config.Spec.SlotsPerEpoch)
+	assert.Equal(t, 0, *config.Spec.Altair)
+	assert.Nil(t, config.Spec.Bellatrix)
+	assert.Equal(t, "0x01000000", config.Spec.AltairForkVersion.String())
+	assert.Equal(t, uint64(20000000000), config.Spec.EjectionBalance)
+	assert.Equal(t, 65536, config.Spec.ChurnLimitQuotient)
+}
+
+type mockDeployer struct {
+	tranches []uint64
+	reqs     []*proto.NodeDeployRequest
+}
+
+func (m *mockDeployer) DepositCreate(ctx context.Context, req *proto.DepositCreateRequest) (*proto.DepositCreateResponse, error) {
+	m.tranches = append(m.tranches, req.NumValidators)
+	return &proto.DepositCreateResponse{}, nil
+}
+
+func (m *mockDeployer) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (*proto.NodeDeployResponse, error) {
+	m.reqs = append(m.reqs, req)
+
+	name := strings.ToLower(req.NodeClient.String())
+	resp := &proto.NodeDeployResponse{}
+	if beacon, ok := req.NodeType.(*proto.NodeDeployRequest_Beacon_); ok {
+		for i := 0; i < int(beacon.Beacon.Count); i++ {
+			resp.Nodes = append(resp.Nodes, &proto.Node{Name: fmt.Sprintf("beacon-%d-%s", i, name)})
+		}
+	} else {
+		resp.Nodes = append(resp.Nodes, &proto.Node{Name: "validator-" + name})
+	}
+	return resp, nil
+}
+
+func TestManifest_Preset(t *testing.T) {
+	m, err := Parse([]byte("spec:\n  preset: minimal\n  epochs_per_eth1_voting_period: 2"))
+	require.NoError(t, err)
+
+	config, err := m.Config()
+	require.NoError(t, err)
+
+	// the values of the spec override the ones of the preset
+	assert.Equal(t, "minimal", config.Spec.Preset)
+	assert.Equal(t, 8, config.Spec.SlotsPerEpoch)
+	assert.Equal(t, 2, config.Spec.EpochsPerEth1VotingPeriod)
+}
+
+func TestManifest_Apply(t *testing.T) {
+	m, err := Parse([]byte(testManifest))
+	require.NoError(t, err)
+
+	deployer := &mockDeployer{}
+	nodes, err := m.Apply(context.Background(), deployer)
+	require.NoError(t, err)
+	assert.Len(t, nodes, 5)
+
+	assert.Equal(t, []uint64{4}, deployer.tranches)
+
+	// the beacon nodes are deployed before the validators
+	require.Len(t, deployer.reqs, 4)
+	assert.Equal(t, uint64(2), deployer.reqs[0].GetBeacon().Count)
+	assert.Equal(t, proto.NodeClient_Lighthouse, deployer.reqs[0].NodeClient)
+	assert.Equal(t, uint64(1), deployer.reqs[1].GetBeacon().Count)
+	assert.Equal(t, "custom", deployer.reqs[1].Tag)
+	assert.Equal(t, proto.RestartPolicy_OnFailure, deployer.reqs[1].RestartPolicy)
+	assert.Equal(t, uint64(3), deployer.reqs[1].MaxRetries)
+	assert.Equal(t, proto.RestartPolicy_Never, deployer.reqs[0].RestartPolicy)
+
+	// the validator connects to the first beacon node of the group
+	validator := deployer.reqs[2].GetValidator()
+	assert.Equal(t, "beacon-0-lighthouse", validator.Beacon)
+	assert.Equal(t, uint64(0), validator.NumTranch)
+
+	validator = deployer.reqs[3].GetValidator()
+	assert.Equal(t, "", validator.Beacon)
+	assert.Equal(t, uint64(2), validator.NumTranch)
+}
+
+func TestManifest_Validate(t *testing.T) {
+	cases := []struct {
+		manifest string
+		err      string
+	}{
+		{
+			"nodes:\n  - type: beacon\n    client: unknown",
+			"client 'unknown' not found",
+		},
+		{
+			"nodes:\n  - type: other\n    client: teku",
+			"type 'other' not found",
+		},
+		{
+			"nodes:\n  - type: validator\n    client: teku",
+			"either tranche or validators must be set",
+		},
+		{
+			"nodes:\n  - type: validator\n    client: teku\n    tranche: 1",
+			"tranche 1 does not exists",
+		},
+		{
+			"nodes:\n  - type: validator\n    client: teku\n    tranche: 0\n  - type: validator\n    client: teku\n    tranche: 0",
+			"tranche 0 is already used",
+		},
+		{
+			"nodes:\n  - type: validator\n    client: teku\n    validators: 2\n    beacon: other",
+			"beacon 'other' not found",
+		},
+		{
+			"nodes:\n  - type: beacon\n    client: teku\n    restart: sometimes",
+			"restart policy 'sometimes' not found",
+		},
+		{
+			"genesis:\n  validators: 3\n  tranches: 2",
+			"multiple of the number of tranches",
+		},
+		{
+			"unknown: true",
+			"field unknown not found",
+		},
+		{
+			"spec:\n  seconds_per_slot: 0",
+			"seconds per slot must be greater than zero",
+		},
+		{
+			"spec:\n  altair: 1\n  altair_fork_version: \"0x00000000\"",
+			"altair fork version must be different",
+		},
+		{
+			"spec:\n  genesis_fork_version: \"0x01\"",
+			"must be 4 bytes long",
+		},
+		{
+			"mnemonic: test test",
+			"invalid mnemonic",
+		},
+		{
+			"spec:\n  preset: devnet",
+			"preset 'devnet' not found",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := Parse([]byte(c.manifest))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), c.err)
+	}
+}
@@ -0,0 +1,549 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+var _ spec.Runtime = &Process{}
+
+// Process is a runtime that runs each spec as a process in the host
+// instead of a container. Each container repository has to be mapped
+// to a local binary.
+type Process struct {
+	logger   hclog.Logger
+	binaries map[string]string
+}
+
+func NewProcess() *Process {
+	p := &Process{
+		logger:   hclog.L(),
+		binaries: map[string]string{},
+	}
+	return p
+}
+
+func (p *Process) SetLogger(logger hclog.Logger) {
+	p.logger = logger
+}
+
+// SetBinary sets the local binary to use for the specs of a given repository
+func (p *Process) SetBinary(repository string, path string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(absPath); err != nil {
+		return fmt.Errorf("binary for '%s' not found: %v", repository, err)
+	}
+	p.binaries[repository] = absPath
+	return nil
+}
+
+type node struct {
+	logger   hclog.Logger
+	opts     *spec.Spec
+	mountMap map[string]string
+	dataDir  string
+	args     []string
+	env      []string
+	output   io.Writer
+
+	lock       sync.Mutex
+	cmd        *exec.Cmd
+	waitCh     chan struct{}
+	exitResult *spec.ExitResult
+	startedAt  time.Time
+	ports      map[string]uint64
+	logs       logBuffer
+}
+
+func (p *Process) Deploy(spec *spec.Spec) (res spec.Node, err error) {
+	binary, ok := p.binaries[spec.Repository]
+	if !ok {
+		return nil, fmt.Errorf("no binary found for repository '%s'", spec.Repository)
+	}
+
+	// setup configuration
+	dirPrefix := "node-"
+	if spec.Name != "" {
+		dirPrefix += spec.Name + "-"
+	}
+
+	// every node has its own data dir with a folder for each mount path
+	dataDir, err := ioutil.TempDir("/tmp", dirPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var n *node
+	defer func() {
+		if err == nil {
+			return
+		}
+		// the process runs in its own group and it would outlive the server
+		if n != nil && n.WaitCh() != nil {
+			if stopErr := n.Stop(); stopErr != nil {
+				p.logger.Error("failed to stop process", "name", spec.Name, "err", stopErr)
+			}
+		}
+		if rmErr := os.RemoveAll(dataDir); rmErr != nil {
+			p.logger.Error("failed to remove data dir", "name", spec.Name, "err", rmErr)
+		}
+	}()
+
+	mountMap := map[string]string{}
+	for _, mount := range spec.Mount {
+		mountPath := filepath.Join(dataDir, mount)
+		if err := os.MkdirAll(mountPath, 0755); err != nil {
+			return nil, err
+		}
+		mountMap[mount] = mountPath
+	}
+
+	// build the files
+	for path, content := range spec.Files {
+		localPath, ok := localPath(mountMap, path)
+		if !ok {
+			return nil, fmt.Errorf("mount match for '%s' not found", path)
+		}
+
+		// create all the directory paths required
+		parentDir := filepath.Dir(localPath)
+		if err := os.MkdirAll(parentDir, 0700); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(localPath, content, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	n = &node{
+		logger:   p.logger,
+		opts:     spec,
+		mountMap: mountMap,
+		dataDir:  dataDir,
+		ports:    map[string]uint64{},
+	}
+
+	// build CLI arguments which might include template arguments
+	// and references to the mount paths
+	cmdArgs := []string{}
+	for _, cmd := range spec.Cmd {
+		cleanCmd, err := n.execCmd(cmd)
+		if err != nil {
+			return nil, err
+		}
+		cmdArgs = append(cmdArgs, n.replaceMounts(cleanCmd))
+	}
+
+	var args []string
+	if len(spec.Entrypoint) != 0 {
+		// the entrypoint (i.e. a shell) is expected to find the binary
+		// in the path
+		for _, arg := range spec.Entrypoint {
+			args = append(args, n.replaceMounts(arg))
+		}
+		args = append(args, cmdArgs...)
+	} else {
+		// the command of images without an entrypoint starts
+		// with the name of the binary
+		if len(cmdArgs) != 0 && cmdArgs[0] == filepath.Base(binary) {
+			cmdArgs = cmdArgs[1:]
+		}
+		args = append([]string{binary}, cmdArgs...)
+	}
+
+	writers := []io.Writer{&lockedWriter{n: n}}
+	writers = append(writers, spec.Output...)
+
+	n.args = args
+	n.env = append(os.Environ(), "PATH="+filepath.Dir(binary)+string(os.PathListSeparator)+os.Getenv("PATH"))
+	n.env = append(n.env, spec.EnvList()...)
+	n.output = io.MultiWriter(writers...)
+
+	if err := n.startCmd(); err != nil {
+		return nil, err
+	}
+
+	if spec.Retry != nil {
+		if err := n.retryFn(defaultTimeoutDuration, func() error {
+			return spec.Retry(n)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+// startCmd starts a new process for the node with the same arguments
+func (n *node) startCmd() error {
+	cmd := exec.Command(n.args[0], n.args[1:]...)
+	cmd.Stdout = n.output
+	cmd.Stderr = n.output
+	cmd.Env = n.env
+	cmd.Dir = n.dataDir
+	// run the process in its own group so that it does not
+	// receive the signals sent to the server
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not start process: %v", err)
+	}
+	waitCh := make(chan struct{})
+
+	n.lock.Lock()
+	n.cmd = cmd
+	n.waitCh = waitCh
+	n.exitResult = nil
+	n.startedAt = time.Now()
+	n.lock.Unlock()
+
+	n.logger.Debug("process started", "name", n.opts.Name, "pid", cmd.Process.Pid, "dir", n.dataDir)
+
+	go n.run(cmd, waitCh)
+	return nil
+}
+
+// Attach implements the spec.Runtime interface. The process runtime cannot
+// attach to processes started by another server.
+func (p *Process) Attach(spec *spec.Spec, id string) (spec.Node, error) {
+	return nil, fmt.Errorf("the process runtime cannot attach to node '%s'", spec.Name)
+}
+
+// localPath returns the path in the data dir of a path inside a mount. The
+// path has to be the mount or a path under it (i.e. /data does not match /database).
+func localPath(mountMap map[string]string, path string) (string, bool) {
+	found := ""
+	for mount := range mountMap {
+		if path != mount && !strings.HasPrefix(path, mount+"/") {
+			continue
+		}
+		// match the longest mount in case they are nested
+		if len(mount) > len(found) {
+			found = mount
+		}
+	}
+	if found == "" {
+		return "", false
+	}
+	return filepath.Join(mountMap[found], strings.TrimPrefix(path, found)), true
+}
+
+// replaceMounts replaces any reference to a mount path with the local data dir.
+// Only the paths that start with the mount are replaced, not the ones that
+// include it as a substring (i.e. /data in /database or /app/data).
+func (n *node) replaceMounts(arg string) string {
+	mounts := []string{}
+	for mount := range n.mountMap {
+		mounts = append(mounts, mount)
+	}
+	// match the longest mounts first in case they are nested
+	sort.Slice(mounts, func(i, j int) bool {
+		return len(mounts[i]) > len(mounts[j])
+	})
+
+	var res strings.Builder
+	for i := 0; i < len(arg); {
+		found := ""
+		if i == 0 || !isPathChar(arg[i-1]) {
+			for _, mount := range mounts {
+				if !strings.HasPrefix(arg[i:], mount) {
+					continue
+				}
+				end := i + len(mount)
+				if end == len(arg) || arg[end] == '/' || !isPathChar(arg[end]) {
+					found = mount
+					break
+				}
+			}
+		}
+		if found != "" {
+			res.WriteString(n.mountMap[found])
+			i += len(found)
+		} else {
+			res.WriteByte(arg[i])
+			i++
+		}
+	}
+	return res.String()
+}
+
+// isPathChar returns true if the character can be part of a path
+func isPathChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '/' || c == '.' || c == '_' || c == '-'
+}
+
+func (n *node) ID() string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return fmt.Sprintf("%d", n.cmd.Process.Pid)
+}
+
+func (n *node) Spec() *spec.Spec {
+	return n.opts
+}
+
+func (n *node) execCmd(cmd string) (string, error) {
+	t := template.New("node_cmd")
+	t.Funcs(template.FuncMap{
+		"Port": func(name proto.NodePort) string {
+			port, err := n.getPort(string(name))
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%d", port)
+		},
+	})
+
+	t, err := t.Parse(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, nil); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// getPort returns the host port for a named port. Since all the processes
+// share the host network, each one is allocated a free port.
+func (n *node) getPort(name string) (uint64, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if port, ok := n.ports[name]; ok {
+		return port, nil
+	}
+	port, err := freePort()
+	if err != nil {
+		return 0, err
+	}
+	n.ports[name] = port
+	return port, nil
+}
+
+func freePort() (uint64, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer lis.Close()
+
+	return uint64(lis.Addr().(*net.TCPAddr).Port), nil
+}
+
+func (n *node) WaitCh() <-chan struct{} {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.waitCh
+}
+
+func (n *node) run(cmd *exec.Cmd, waitCh chan struct{}) {
+	result := &spec.ExitResult{}
+	if err := cmd.Wait(); err != nil {
+		result.Err = err
+	}
+	// the exit code is -1 if the process was killed by a signal
+	result.ExitCode = int64(cmd.ProcessState.ExitCode())
+
+	n.lock.Lock()
+	n.exitResult = result
+	n.lock.Unlock()
+
+	close(waitCh)
+}
+
+func (n *node) ExitResult() *spec.ExitResult {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.exitResult
+}
+
+func (n *node) Info() (*spec.NodeInfo, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	state := "running"
+	if n.exitResult != nil {
+		state = "exited"
+	}
+	info := &spec.NodeInfo{
+		Image:     n.args[0],
+		State:     state,
+		StartedAt: n.startedAt,
+		Mounts:    map[string]string{},
+		Ports:     map[string]string{},
+	}
+	for mount, local := range n.mountMap {
+		info.Mounts[mount] = local
+	}
+	for name, port := range n.ports {
+		info.Ports[name] = fmt.Sprintf("127.0.0.1:%d", port)
+	}
+	return info, nil
+}
+
+func (n *node) GetAddr(portName string) string {
+	port, err := n.getPort(portName)
+	if err != nil {
+		panic(fmt.Errorf("port '%s' not found: %v", portName, err))
+	}
+	return fmt.Sprintf("http://%s:%d", n.IP(), port)
+}
+
+var stopTimeout = 10 * time.Second
+
+func (n *node) Stop() error {
+	n.lock.Lock()
+	cmd, waitCh := n.cmd, n.waitCh
+	n.lock.Unlock()
+
+	select {
+	case <-waitCh:
+		return nil
+	default:
+	}
+
+	// signal the whole process group since the binary might
+	// be running as a child of a shell entrypoint
+	pgid := -cmd.Process.Pid
+	if err := syscall.Kill(pgid, syscall.SIGTERM); err != nil {
+		return fmt.Errorf("failed to stop process: %v", err)
+	}
+	select {
+	case <-waitCh:
+	case <-time.After(stopTimeout):
+		if err := syscall.Kill(pgid, syscall.SIGKILL); err != nil {
+			return fmt.Errorf("failed to kill process: %v", err)
+		}
+		<-waitCh
+	}
+	return nil
+}
+
+// Start runs again the process of a stopped node in the same data dir
+func (n *node) Start() error {
+	select {
+	case <-n.WaitCh():
+	default:
+		return fmt.Errorf("node is already running")
+	}
+
+	if err := n.startCmd(); err != nil {
+		return err
+	}
+	if n.opts.Retry != nil {
+		if err := n.retryFn(defaultTimeoutDuration, func() error {
+			return n.opts.Retry(n)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove stops the process and removes the data dir of the node
+func (n *node) Remove() error {
+	if err := n.Stop(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(n.dataDir); err != nil {
+		return fmt.Errorf("failed to remove data dir: %v", err)
+	}
+	return nil
+}
+
+func (n *node) GetLogs() (string, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.logs.String(), nil
+}
+
+func (n *node) IP() string {
+	return "127.0.0.1"
+}
+
+// lockedWriter writes the output of the process into the logs of the node
+type lockedWriter struct {
+	n *node
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.n.lock.Lock()
+	defer l.n.lock.Unlock()
+
+	return l.n.logs.Write(p)
+}
+
+// maxLogSize is the size of the most recent logs of a node kept in memory.
+// The full output of the node is written to the outputs of the spec.
+var maxLogSize = 1024 * 1024
+
+// logBuffer keeps the most recent lines written up to maxLogSize
+type logBuffer struct {
+	buf []byte
+}
+
+func (l *logBuffer) Write(p []byte) (int, error) {
+	l.buf = append(l.buf, p...)
+	if len(l.buf) > maxLogSize {
+		// drop the oldest logs up to the start of the next line
+		drop := len(l.buf) - maxLogSize
+		if indx := bytes.IndexByte(l.buf[drop:], '\n'); indx != -1 {
+			drop += indx + 1
+		}
+		l.buf = append(l.buf[:0], l.buf[drop:]...)
+	}
+	return len(p), nil
+}
+
+func (l *logBuffer) String() string {
+	return string(l.buf)
+}
+
+var defaultTimeoutDuration = 1 * time.Minute
+
+func (n *node) retryFn(timeout time.Duration, handler func() error) error {
+	timeoutT := time.NewTimer(timeout)
+	waitCh := n.WaitCh()
+
+	for {
+		select {
+		case <-time.After(100 * time.Millisecond):
+			if err := handler(); err == nil {
+				return nil
+			}
+
+		case <-waitCh:
+			return fmt.Errorf("node stopped")
+
+		case <-timeoutT.C:
+			return fmt.Errorf("timeout")
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+func TestProcess_Deploy(t *testing.T) {
+	p := NewProcess()
+	require.NoError(t, p.SetBinary("shell", "/bin/sh"))
+
+	var output bytes.Buffer
+
+	cmd := []string{
+		"echo", `port={{ Port "eth2.http" }}`,
+		"&&",
+		"cat", "/data/config.yaml",
+		"&&",
+		"sleep", "30",
+	}
+
+	ss := &spec.Spec{}
+	ss.WithName("test").
+		WithContainer("shell").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{strings.Join(cmd, " ")}).
+		WithMount("/data").
+		WithFile("/data/config.yaml", "a: b").
+		WithOutput(&output).
+		WithRetry(func(n spec.Node) error {
+			logs, err := n.GetLogs()
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(logs, "a: b") {
+				return fmt.Errorf("not ready")
+			}
+			return nil
+		})
+
+	n, err := p.Deploy(ss)
+	require.NoError(t, err)
+
+	// the template port is the same one returned by the address
+	logs, err := n.GetLogs()
+	require.NoError(t, err)
+	assert.Contains(t, n.GetAddr(proto.NodePortHttp), strings.TrimPrefix(strings.Split(logs, "\n")[0], "port="))
+
+	// the files are written in the data dir of the node
+	data, err := ioutil.ReadFile(filepath.Join(n.(*node).mountMap["/data"], "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "a: b", string(data))
+
+	info, err := n.Info()
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/sh", info.Image)
+	assert.Equal(t, "running", info.State)
+	assert.Equal(t, n.(*node).mountMap["/data"], info.Mounts["/data"])
+	assert.Contains(t, n.GetAddr(proto.NodePortHttp), info.Ports[proto.NodePortHttp])
+
+	require.NoError(t, n.Stop())
+
+	select {
+	case <-n.WaitCh():
+	case <-time.After(5 * time.Second):
+		t.Fatal("node not stopped")
+	}
+	assert.Contains(t, output.String(), "a: b")
+}
+
+func TestProcess_Restart(t *testing.T) {
+	p := NewProcess()
+	require.NoError(t, p.SetBinary("shell", "/bin/sh"))
+
+	ss := &spec.Spec{}
+	ss.WithName("test").
+		WithContainer("shell").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{"echo run >> /data/runs && echo runs=$(wc -l < /data/runs) && sleep 30"}).
+		WithMount("/data").
+		WithRetry(func(n spec.Node) error {
+			logs, err := n.GetLogs()
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(logs, "runs=") {
+				return fmt.Errorf("not ready")
+			}
+			return nil
+		})
+
+	n, err := p.Deploy(ss)
+	require.NoError(t, err)
+
+	// a running node cannot be started
+	require.Error(t, n.Start())
+
+	require.NoError(t, n.Stop())
+	require.NoError(t, n.Start())
+
+	// the second run uses the same data dir
+	assert.Eventually(t, func() bool {
+		logs, _ := n.GetLogs()
+		return strings.Contains(logs, "runs=2")
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, n.Remove())
+
+	_, err = os.Stat(n.(*node).dataDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestProcess_Env(t *testing.T) {
+	p := NewProcess()
+	require.NoError(t, p.SetBinary("shell", "/bin/sh"))
+
+	ss := &spec.Spec{}
+	ss.WithName("test").
+		WithContainer("shell").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{"echo preset=$PRESET && sleep 30"}).
+		WithEnv("PRESET", "minimal").
+		WithRetry(func(n spec.Node) error {
+			logs, err := n.GetLogs()
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(logs, "preset=") {
+				return fmt.Errorf("not ready")
+			}
+			return nil
+		})
+
+	n, err := p.Deploy(ss)
+	require.NoError(t, err)
+	defer n.Remove()
+
+	logs, err := n.GetLogs()
+	require.NoError(t, err)
+	assert.Contains(t, logs, "preset=minimal")
+}
+
+func TestProcess_ReplaceMounts(t *testing.T) {
+	n := &node{
+		mountMap: map[string]string{
+			"/data":        "/tmp/node/data",
+			"/data/config": "/tmp/node/config",
+		},
+	}
+
+	cases := map[string]string{
+		"/data":                    "/tmp/node/data",
+		"--datadir=/data/chain":    "--datadir=/tmp/node/data/chain",
+		"--config /data/config/a":  "--config /tmp/node/config/a",
+		"/database":                "/database",
+		"/app/data":                "/app/data",
+		"--db=/data.db":            "--db=/data.db",
+		"/data:/data/config":       "/tmp/node/data:/tmp/node/config",
+		"https://example.com/data": "https://example.com/data",
+	}
+	for arg, expected := range cases {
+		assert.Equal(t, expected, n.replaceMounts(arg), arg)
+	}
+}
+
+func TestProcess_LocalPath(t *testing.T) {
+	mountMap := map[string]string{
+		"/data":        "/tmp/node/data",
+		"/data/config": "/tmp/node/config",
+	}
+
+	cases := map[string]string{
+		"/data":                "/tmp/node/data",
+		"/data/genesis.ssz":    "/tmp/node/data/genesis.ssz",
+		"/data/config/a.yaml":  "/tmp/node/config/a.yaml",
+		"/data/configs/a.yaml": "/tmp/node/data/configs/a.yaml",
+	}
+	for path, expected := range cases {
+		local, ok := localPath(mountMap, path)
+		require.True(t, ok, path)
+		assert.Equal(t, expected, local, path)
+	}
+
+	for _, path := range []string{"/database/a", "/app/data/a", "/data.db"} {
+		_, ok := localPath(mountMap, path)
+		assert.False(t, ok, path)
+	}
+}
+
+func TestProcess_DeployFails(t *testing.T) {
+	defaultTimeoutDuration = 500 * time.Millisecond
+	defer func() {
+		defaultTimeoutDuration = 1 * time.Minute
+	}()
+
+	p := NewProcess()
+	require.NoError(t, p.SetBinary("shell", "/bin/sh"))
+
+	pidFile := filepath.Join(t.TempDir(), "pid")
+
+	var dataDir string
+	ss := &spec.Spec{}
+	ss.WithName("test").
+		WithContainer("shell").
+		WithEntrypoint([]string{"/bin/sh", "-c"}).
+		WithCmd([]string{"echo $$ > " + pidFile + " && sleep 30"}).
+		WithMount("/data").
+		WithRetry(func(n spec.Node) error {
+			dataDir = n.(*node).dataDir
+			return fmt.Errorf("not ready")
+		})
+
+	_, err := p.Deploy(ss)
+	require.Error(t, err)
+
+	// the process group is stopped and the data dir is removed
+	data, err := ioutil.ReadFile(pidFile)
+	require.NoError(t, err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	assert.Equal(t, syscall.ESRCH, syscall.Kill(pid, 0))
+
+	require.NotEmpty(t, dataDir)
+	_, err = os.Stat(dataDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestProcess_LogBuffer(t *testing.T) {
+	maxLogSize = 16
+	defer func() {
+		maxLogSize = 1024 * 1024
+	}()
+
+	var logs logBuffer
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(&logs, "line %d\n", i)
+	}
+	// only the most recent complete lines are kept
+	assert.Equal(t, "line 8\nline 9\n", logs.String())
+}
+
+func TestProcess_BinaryNotFound(t *testing.T) {
+	p := NewProcess()
+
+	_, err := p.Deploy((&spec.Spec{}).WithContainer("sigp/lighthouse"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// beaconAPITimeout is the timeout of each query to the beacon API of a node
+const beaconAPITimeout = 2 * time.Second
+
+// beaconAPI is a client for the standard beacon API of a beacon node
+type beaconAPI struct {
+	addr   string
+	client *http.Client
+}
+
+func newBeaconAPI(addr string) *beaconAPI {
+	return &beaconAPI{
+		addr:   strings.TrimSuffix(addr, "/"),
+		client: &http.Client{Timeout: beaconAPITimeout},
+	}
+}
+
+// get queries an endpoint and decodes the 'data' field of the response
+func (b *beaconAPI) get(path string, out interface{}) error {
+	resp, err := b.client.Get(b.addr + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request %s failed with status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var obj struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	return json.Unmarshal(obj.Data, out)
+}
+
+// post sends the object encoded as json to an endpoint
+func (b *beaconAPI) post(path string, obj interface{}) error {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Post(b.addr+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request %s failed with status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+type beaconSyncing struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+}
+
+type beaconCheckpoint struct {
+	Epoch uint64 `json:"epoch,string"`
+}
+
+type beaconFinality struct {
+	CurrentJustified *beaconCheckpoint `json:"current_justified"`
+	Finalized        *beaconCheckpoint `json:"finalized"`
+}
+
+type beaconPeerCount struct {
+	Connected uint64 `json:"connected,string"`
+}
+
+type beaconVersion struct {
+	Version string `json:"version"`
+}
+
+// status queries the sync, chain and network status of the beacon node. If any
+// of the queries fails, the error is set in the response with the data
+// gathered until then.
+func (b *beaconAPI) status() *proto.BeaconStatus {
+	status := &proto.BeaconStatus{}
+	if err := b.fillStatus(status); err != nil {
+		status.Error = err.Error()
+	}
+	return status
+}
+
+func (b *beaconAPI) fillStatus(status *proto.BeaconStatus) error {
+	var syncing beaconSyncing
+	if err := b.get("/eth/v1/node/syncing", &syncing); err != nil {
+		return err
+	}
+	status.HeadSlot = syncing.HeadSlot
+	status.SyncDistance = syncing.SyncDistance
+	status.IsSyncing = syncing.IsSyncing
+
+	var finality beaconFinality
+	if err := b.get("/eth/v1/beacon/states/head/finality_checkpoints", &finality); err != nil {
+		return err
+	}
+	if finality.Finalized != nil {
+		status.FinalizedEpoch = finality.Finalized.Epoch
+	}
+	if finality.CurrentJustified != nil {
+		status.JustifiedEpoch = finality.CurrentJustified.Epoch
+	}
+
+	var peers beaconPeerCount
+	if err := b.get("/eth/v1/node/peer_count", &peers); err != nil {
+		return err
+	}
+	status.Peers = peers.Connected
+
+	var version beaconVersion
+	if err := b.get("/eth/v1/node/version", &version); err != nil {
+		return err
+	}
+	status.Version = version.Version
+	return nil
+}
+
+type beaconGenesis struct {
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// genesisValidatorsRoot returns the genesis validators root of the chain
+func (b *beaconAPI) genesisValidatorsRoot() ([32]byte, error) {
+	var genesis beaconGenesis
+	if err := b.get("/eth/v1/beacon/genesis", &genesis); err != nil {
+		return [32]byte{}, err
+	}
+	var root [32]byte
+	buf, err := hex.DecodeString(strings.TrimPrefix(genesis.GenesisValidatorsRoot, "0x"))
+	if err != nil {
+		return root, err
+	}
+	if len(buf) != 32 {
+		return root, fmt.Errorf("genesis validators root of %d bytes, expected 32", len(buf))
+	}
+	copy(root[:], buf)
+	return root, nil
+}
+
+// headSlot returns the slot of the head of the chain
+func (b *beaconAPI) headSlot() (uint64, error) {
+	var syncing beaconSyncing
+	if err := b.get("/eth/v1/node/syncing", &syncing); err != nil {
+		return 0, err
+	}
+	return syncing.HeadSlot, nil
+}
+
+type beaconValidator struct {
+	Index     uint64 `json:"index,string"`
+	Status    string `json:"status"`
+	Validator struct {
+		Pubkey    string `json:"pubkey"`
+		ExitEpoch uint64 `json:"exit_epoch,string"`
+	} `json:"validator"`
+}
+
+// validators returns the validators of the head state with the given public keys.
+// The validators that are not in the state are not included.
+func (b *beaconAPI) validators(pubKeys []string) ([]*beaconValidator, error) {
+	var validators []*beaconValidator
+	if err := b.get("/eth/v1/beacon/states/head/validators?id="+strings.Join(pubKeys, ","), &validators); err != nil {
+		return nil, err
+	}
+	return validators, nil
+}
+
+type beaconVoluntaryExit struct {
+	Message struct {
+		Epoch          uint64 `json:"epoch,string"`
+		ValidatorIndex uint64 `json:"validator_index,string"`
+	} `json:"message"`
+	Signature string `json:"signature"`
+}
+
+// submitVoluntaryExit submits a signed voluntary exit to the operations pool
+func (b *beaconAPI) submitVoluntaryExit(exit *consensus.SignedVoluntaryExit) error {
+	obj := &beaconVoluntaryExit{}
+	obj.Message.Epoch = exit.Exit.Epoch
+	obj.Message.ValidatorIndex = exit.Exit.ValidatorIndex
+	obj.Signature = "0x" + hex.EncodeToString(exit.Signature[:])
+
+	return b.post("/eth/v1/beacon/pool/voluntary_exits", obj)
+}
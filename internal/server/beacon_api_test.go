@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestBeaconAPI starts an http server with the endpoints of the beacon API
+// used by the status of the beacon nodes
+func newTestBeaconAPI(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"/eth/v1/node/syncing":                            `{"data":{"head_slot":"120","sync_distance":"2","is_syncing":true}}`,
+		"/eth/v1/beacon/states/head/finality_checkpoints": `{"data":{"previous_justified":{"epoch":"2","root":"0x00"},"current_justified":{"epoch":"3","root":"0x00"},"finalized":{"epoch":"2","root":"0x00"}}}`,
+		"/eth/v1/node/peer_count":                         `{"data":{"disconnected":"1","connecting":"0","connected":"4","disconnecting":"0"}}`,
+		"/eth/v1/node/version":                            `{"data":{"version":"teku/v22.5.0"}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestBeaconAPI_Status(t *testing.T) {
+	api := newTestBeaconAPI(t)
+
+	status := newBeaconAPI(api.URL).status()
+	assert.Empty(t, status.Error)
+	assert.Equal(t, uint64(120), status.HeadSlot)
+	assert.Equal(t, uint64(2), status.SyncDistance)
+	assert.True(t, status.IsSyncing)
+	assert.Equal(t, uint64(3), status.JustifiedEpoch)
+	assert.Equal(t, uint64(2), status.FinalizedEpoch)
+	assert.Equal(t, uint64(4), status.Peers)
+	assert.Equal(t, "teku/v22.5.0", status.Version)
+}
+
+func TestBeaconAPI_Unreachable(t *testing.T) {
+	api := newTestBeaconAPI(t)
+	api.Close()
+
+	status := newBeaconAPI(api.URL).status()
+	assert.NotEmpty(t, status.Error)
+}
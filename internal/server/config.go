@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"embed"
 	"encoding"
+	"encoding/hex"
 	"fmt"
 	"html/template"
+	"strings"
 	"time"
+
+	"github.com/umbracle/viewpoint/internal/genesis"
+	"github.com/umbracle/viewpoint/internal/server/proto"
 )
 
 var (
@@ -19,6 +24,53 @@ type Config struct {
 	Spec                 *Eth2Spec
 	NumTranches          uint64
 	NumGenesisValidators uint64
+
+	// DataDir is the directory where the e2e-<name> folder of the
+	// network is created. It defaults to the working directory.
+	DataDir string
+
+	// GrpcAddr is the listen address of the grpc server. It can be a
+	// unix socket (unix:///path) or a tcp address with port 0.
+	GrpcAddr string
+
+	// GrpcTLS enables TLS in the grpc server with a self-signed certificate
+	GrpcTLS bool
+
+	// GrpcToken is the bearer token required by the grpc server.
+	// It is not persisted with the state of the network.
+	GrpcToken string `json:"-"`
+
+	// Mnemonic is the BIP-39 mnemonic used to derive the keys of
+	// the validators. Seed is an hex encoded seed used instead of
+	// the mnemonic. The keys are random if none of them is set.
+	Mnemonic string
+	Seed     string
+
+	// GenesisAccounts are existing validator accounts (i.e. imported
+	// from keystores) included in the genesis in their own tranche
+	GenesisAccounts []*proto.Account `json:"-"`
+}
+
+// KeySeed returns the seed to derive the keys of the validators
+// or nil if the keys are generated at random
+func (c *Config) KeySeed() ([]byte, error) {
+	if c.Mnemonic != "" && c.Seed != "" {
+		return nil, fmt.Errorf("mnemonic and seed cannot be set at the same time")
+	}
+	if c.Mnemonic != "" {
+		return proto.MnemonicToSeed(c.Mnemonic)
+	}
+	if c.Seed != "" {
+		seed, err := hex.DecodeString(strings.TrimPrefix(c.Seed, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode seed: %v", err)
+		}
+		if len(seed) < 32 || len(seed) > 64 {
+			return nil, fmt.Errorf("seed of %d bytes, expected between 32 and 64", len(seed))
+		}
+		return seed, nil
+	}
+	return nil, nil
 }
 
 func DefaultConfig() *Config {
@@ -27,11 +79,15 @@ func DefaultConfig() *Config {
 		Spec:                 DefaultEth2Spec(),
 		NumTranches:          1,
 		NumGenesisValidators: 1,
+		GrpcAddr:             "localhost:5555",
 	}
 }
 
 // Eth2Spec is the config of the Eth2.0 node
 type Eth2Spec struct {
+	// Preset is the preset of the network (mainnet or minimal)
+	Preset string
+
 	MinGenesisValidatorCount  int
 	GenesisDelay              int
 	MinGenesisTime            int
@@ -44,20 +100,166 @@ type Eth2Spec struct {
 	DepositContract           string
 	Altair                    *int
 	Bellatrix                 *int
+
+	// TerminalTotalDifficulty is the total difficulty of the execution
+	// chain that triggers the merge. It is only used if Bellatrix is enabled.
+	TerminalTotalDifficulty uint64
+
+	// fork versions
+	GenesisForkVersion   ForkVersion
+	AltairForkVersion    ForkVersion
+	BellatrixForkVersion ForkVersion
+
+	// validator cycle
+	MinValidatorWithdrawabilityDelay int
+	EjectionBalance                  uint64
+	MinPerEpochChurnLimit            int
+	ChurnLimitQuotient               int
+
+	// inactivity leak
+	InactivityScoreBias         int
+	InactivityScoreRecoveryRate int
+
+	// fork choice
+	ProposerScoreBoost int
 }
 
 func DefaultEth2Spec() *Eth2Spec {
 	return &Eth2Spec{
-		MinGenesisValidatorCount:  1,
-		GenesisDelay:              10,
-		MinGenesisTime:            int(time.Now().Add(10 * time.Second).Unix()),
-		EthFollowDistance:         1,
-		SecondsPerEth1Block:       1,
-		EpochsPerEth1VotingPeriod: 64,
-		ShardCommitteePeriod:      4,
-		SlotsPerEpoch:             12,
-		SecondsPerSlot:            3,
+		Preset:                           genesis.MainnetPreset.Name,
+		MinGenesisValidatorCount:         1,
+		GenesisDelay:                     10,
+		MinGenesisTime:                   int(time.Now().Add(10 * time.Second).Unix()),
+		EthFollowDistance:                1,
+		SecondsPerEth1Block:              1,
+		EpochsPerEth1VotingPeriod:        64,
+		ShardCommitteePeriod:             4,
+		SlotsPerEpoch:                    12,
+		SecondsPerSlot:                   3,
+		GenesisForkVersion:               ForkVersion{0x0, 0x0, 0x0, 0x0},
+		AltairForkVersion:                ForkVersion{0x80, 0x0, 0x0, 0x70},
+		BellatrixForkVersion:             ForkVersion{0x80, 0x0, 0x0, 0x71},
+		MinValidatorWithdrawabilityDelay: 256,
+		EjectionBalance:                  16000000000,
+		MinPerEpochChurnLimit:            4,
+		ChurnLimitQuotient:               65536,
+		InactivityScoreBias:              4,
+		InactivityScoreRecoveryRate:      16,
+		ProposerScoreBoost:               40,
+	}
+}
+
+// ApplyPreset sets the preset of the spec and the values of the spec
+// that are part of the preset. The mainnet preset keeps the default
+// values since they are already reduced for a test network.
+func (e *Eth2Spec) ApplyPreset(name string) error {
+	preset, ok := genesis.PresetByName(name)
+	if !ok {
+		return fmt.Errorf("preset '%s' not found", name)
+	}
+	e.Preset = preset.Name
+	if preset == genesis.MinimalPreset {
+		e.SlotsPerEpoch = 8
+		e.EpochsPerEth1VotingPeriod = 4
 	}
+	return nil
+}
+
+// maxEffectiveBalance is the max effective balance of a validator in gwei
+const maxEffectiveBalance = 32000000000
+
+// Validate checks that the values of the spec can be used to start a network
+func (e *Eth2Spec) Validate() error {
+	if _, ok := genesis.PresetByName(e.Preset); !ok {
+		return fmt.Errorf("preset '%s' not found", e.Preset)
+	}
+
+	positive := []struct {
+		name string
+		val  int
+	}{
+		{"min genesis validator count", e.MinGenesisValidatorCount},
+		{"seconds per slot", e.SecondsPerSlot},
+		{"slots per epoch", e.SlotsPerEpoch},
+		{"seconds per eth1 block", e.SecondsPerEth1Block},
+		{"epochs per eth1 voting period", e.EpochsPerEth1VotingPeriod},
+		{"min per epoch churn limit", e.MinPerEpochChurnLimit},
+		{"churn limit quotient", e.ChurnLimitQuotient},
+		{"inactivity score bias", e.InactivityScoreBias},
+	}
+	for _, p := range positive {
+		if p.val <= 0 {
+			return fmt.Errorf("%s must be greater than zero", p.name)
+		}
+	}
+
+	notNegative := []struct {
+		name string
+		val  int
+	}{
+		{"genesis delay", e.GenesisDelay},
+		{"eth1 follow distance", e.EthFollowDistance},
+		{"shard committee period", e.ShardCommitteePeriod},
+		{"min validator withdrawability delay", e.MinValidatorWithdrawabilityDelay},
+		{"inactivity score recovery rate", e.InactivityScoreRecoveryRate},
+	}
+	for _, p := range notNegative {
+		if p.val < 0 {
+			return fmt.Errorf("%s cannot be negative", p.name)
+		}
+	}
+
+	if e.EjectionBalance > maxEffectiveBalance {
+		return fmt.Errorf("ejection balance %d is higher than the max effective balance %d", e.EjectionBalance, maxEffectiveBalance)
+	}
+	if e.ProposerScoreBoost < 0 || e.ProposerScoreBoost > 100 {
+		return fmt.Errorf("proposer score boost must be a percentage between 0 and 100")
+	}
+	if e.Altair != nil && *e.Altair < 0 {
+		return fmt.Errorf("altair fork epoch cannot be negative")
+	}
+	if err := e.validateForks(); err != nil {
+		return err
+	}
+
+	// the fork versions are used in the signature domains and must be unique
+	if e.Altair != nil && e.AltairForkVersion == e.GenesisForkVersion {
+		return fmt.Errorf("altair fork version must be different from the genesis fork version")
+	}
+	if e.Bellatrix != nil {
+		if e.BellatrixForkVersion == e.GenesisForkVersion || e.BellatrixForkVersion == e.AltairForkVersion {
+			return fmt.Errorf("bellatrix fork version must be different from the genesis and altair fork versions")
+		}
+	}
+	return nil
+}
+
+// validateForks checks that the forks are enabled in order
+func (e *Eth2Spec) validateForks() error {
+	if e.Bellatrix == nil {
+		return nil
+	}
+	if e.Altair == nil || *e.Altair > *e.Bellatrix {
+		return fmt.Errorf("bellatrix fork requires the altair fork at the same or an earlier epoch")
+	}
+	return nil
+}
+
+// forkTime returns the time when the given epoch starts
+func (e *Eth2Spec) forkTime(epoch int) time.Time {
+	slots := epoch * e.SlotsPerEpoch
+	return time.Unix(int64(e.MinGenesisTime), 0).Add(time.Duration(slots*e.SecondsPerSlot) * time.Second)
+}
+
+// forkVersion returns the version of the fork active at the given epoch
+func (e *Eth2Spec) forkVersion(epoch uint64) ForkVersion {
+	if e.Bellatrix != nil && epoch >= uint64(*e.Bellatrix) {
+		return e.BellatrixForkVersion
+	}
+	if e.Altair != nil && epoch >= uint64(*e.Altair) {
+		return e.AltairForkVersion
+	}
+	return e.GenesisForkVersion
 }
 
 func (e *Eth2Spec) MarshalText() ([]byte, error) {
@@ -77,6 +279,9 @@ func (e *Eth2Spec) buildConfig() []byte {
 			}
 			return string(res)
 		},
+		"version": func(v ForkVersion) string {
+			return v.String()
+		},
 		"fork": func(obj interface{}) string {
 			num, ok := obj.(*int)
 			if !ok {
@@ -105,3 +310,35 @@ func (e *Eth2Spec) buildConfig() []byte {
 	}
 	return tpl.Bytes()
 }
+
+// ForkVersion is the version of a fork. It is encoded as a
+// 0x-prefixed hex string (i.e. 0x80000070).
+type ForkVersion [4]byte
+
+// String implements the flag.Value interface
+func (f ForkVersion) String() string {
+	return "0x" + hex.EncodeToString(f[:])
+}
+
+// Set implements the flag.Value interface
+func (f *ForkVersion) Set(str string) error {
+	buf, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode fork version '%s': %v", str, err)
+	}
+	if len(buf) != 4 {
+		return fmt.Errorf("fork version '%s' must be 4 bytes long", str)
+	}
+	copy(f[:], buf)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (f ForkVersion) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (f *ForkVersion) UnmarshalText(data []byte) error {
+	return f.Set(string(data))
+}
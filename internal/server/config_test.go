@@ -0,0 +1,176 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEth2Spec_Bellatrix(t *testing.T) {
+	spec := DefaultEth2Spec()
+
+	// the merge is disabled by default
+	config := string(spec.buildConfig())
+	assert.Contains(t, config, "BELLATRIX_FORK_EPOCH: 18446744073709551615")
+	assert.Contains(t, config, "TERMINAL_TOTAL_DIFFICULTY: 100000000000000000000000")
+
+	altair, bellatrix := 0, 2
+	spec.Altair = &altair
+	spec.Bellatrix = &bellatrix
+	spec.TerminalTotalDifficulty = 50
+
+	config = string(spec.buildConfig())
+	assert.Contains(t, config, "BELLATRIX_FORK_EPOCH: 2\n")
+	assert.Contains(t, config, "TERMINAL_TOTAL_DIFFICULTY: 50\n")
+}
+
+func TestEth2Spec_ValidateForks(t *testing.T) {
+	spec := DefaultEth2Spec()
+	require.NoError(t, spec.validateForks())
+
+	// bellatrix requires altair
+	bellatrix := 1
+	spec.Bellatrix = &bellatrix
+	require.Error(t, spec.validateForks())
+
+	altair := 2
+	spec.Altair = &altair
+	require.Error(t, spec.validateForks())
+
+	altair = 1
+	require.NoError(t, spec.validateForks())
+}
+
+func TestEth2Spec_ForkTime(t *testing.T) {
+	spec := DefaultEth2Spec()
+	spec.MinGenesisTime = 1000
+	spec.SlotsPerEpoch = 12
+	spec.SecondsPerSlot = 3
+
+	assert.Equal(t, time.Unix(1000, 0), spec.forkTime(0))
+	assert.Equal(t, time.Unix(1072, 0), spec.forkTime(2))
+}
+
+func TestEth2Spec_ForkVersionAtEpoch(t *testing.T) {
+	spec := DefaultEth2Spec()
+	assert.Equal(t, spec.GenesisForkVersion, spec.forkVersion(10))
+
+	altair, bellatrix := 1, 3
+	spec.Altair = &altair
+	spec.Bellatrix = &bellatrix
+
+	assert.Equal(t, spec.GenesisForkVersion, spec.forkVersion(0))
+	assert.Equal(t, spec.AltairForkVersion, spec.forkVersion(1))
+	assert.Equal(t, spec.AltairForkVersion, spec.forkVersion(2))
+	assert.Equal(t, spec.BellatrixForkVersion, spec.forkVersion(3))
+}
+
+func TestEth2Spec_BuildConfig(t *testing.T) {
+	spec := DefaultEth2Spec()
+	spec.SlotsPerEpoch = 8
+	spec.SecondsPerSlot = 2
+	spec.GenesisDelay = 30
+	spec.EthFollowDistance = 5
+	spec.ShardCommitteePeriod = 16
+	spec.EpochsPerEth1VotingPeriod = 4
+	spec.EjectionBalance = 20000000000
+	spec.ChurnLimitQuotient = 32
+	spec.GenesisForkVersion = ForkVersion{0x10, 0x0, 0x0, 0x0}
+
+	config := string(spec.buildConfig())
+	for _, line := range []string{
+		"SLOTS_PER_EPOCH: 8",
+		"SECONDS_PER_SLOT: 2",
+		"GENESIS_DELAY: 30",
+		"ETH1_FOLLOW_DISTANCE: 5",
+		"SHARD_COMMITTEE_PERIOD: 16",
+		"EPOCHS_PER_ETH1_VOTING_PERIOD: 4",
+		"EJECTION_BALANCE: 20000000000",
+		"CHURN_LIMIT_QUOTIENT: 32",
+		"MIN_PER_EPOCH_CHURN_LIMIT: 4",
+		"INACTIVITY_SCORE_BIAS: 4",
+		"INACTIVITY_SCORE_RECOVERY_RATE: 16",
+		"PROPOSER_SCORE_BOOST: 40",
+		"GENESIS_FORK_VERSION: 0x10000000",
+		"ALTAIR_FORK_VERSION: 0x80000070",
+		"BELLATRIX_FORK_VERSION: 0x80000071",
+	} {
+		assert.Contains(t, strings.Split(config, "\n"), line)
+	}
+}
+
+func TestEth2Spec_Validate(t *testing.T) {
+	require.NoError(t, DefaultEth2Spec().Validate())
+
+	cases := []func(spec *Eth2Spec){
+		func(spec *Eth2Spec) { spec.SlotsPerEpoch = 0 },
+		func(spec *Eth2Spec) { spec.SecondsPerSlot = -1 },
+		func(spec *Eth2Spec) { spec.ChurnLimitQuotient = 0 },
+		func(spec *Eth2Spec) { spec.GenesisDelay = -1 },
+		func(spec *Eth2Spec) { spec.EjectionBalance = 33000000000 },
+		func(spec *Eth2Spec) { spec.ProposerScoreBoost = 101 },
+		func(spec *Eth2Spec) { spec.Preset = "devnet" },
+		func(spec *Eth2Spec) {
+			altair := 1
+			spec.Altair = &altair
+			spec.AltairForkVersion = spec.GenesisForkVersion
+		},
+		func(spec *Eth2Spec) {
+			altair, bellatrix := 0, 1
+			spec.Altair, spec.Bellatrix = &altair, &bellatrix
+			spec.BellatrixForkVersion = spec.AltairForkVersion
+		},
+	}
+	for indx, c := range cases {
+		spec := DefaultEth2Spec()
+		c(spec)
+		require.Error(t, spec.Validate(), indx)
+	}
+}
+
+func TestEth2Spec_Preset(t *testing.T) {
+	spec := DefaultEth2Spec()
+	assert.Contains(t, strings.Split(string(spec.buildConfig()), "\n"), "PRESET_BASE: 'mainnet'")
+
+	require.NoError(t, spec.ApplyPreset("minimal"))
+	require.NoError(t, spec.Validate())
+	assert.Equal(t, 8, spec.SlotsPerEpoch)
+	assert.Equal(t, 4, spec.EpochsPerEth1VotingPeriod)
+	assert.Contains(t, strings.Split(string(spec.buildConfig()), "\n"), "PRESET_BASE: 'minimal'")
+
+	require.Error(t, spec.ApplyPreset("devnet"))
+}
+
+func TestForkVersion(t *testing.T) {
+	var v ForkVersion
+	require.NoError(t, v.Set("0x80000070"))
+	assert.Equal(t, ForkVersion{0x80, 0x0, 0x0, 0x70}, v)
+	assert.Equal(t, "0x80000070", v.String())
+
+	require.Error(t, v.Set("0x8000"))
+	require.Error(t, v.Set("0xzz000070"))
+}
+
+func TestConfig_KeySeed(t *testing.T) {
+	config := DefaultConfig()
+	seed, err := config.KeySeed()
+	require.NoError(t, err)
+	assert.Nil(t, seed)
+
+	config.Seed = "0x" + strings.Repeat("01", 32)
+	seed, err = config.KeySeed()
+	require.NoError(t, err)
+	assert.Len(t, seed, 32)
+
+	config.Seed = "0x01"
+	_, err = config.KeySeed()
+	require.Error(t, err)
+
+	config.Seed = strings.Repeat("01", 32)
+	config.Mnemonic = "test test test test test test test test test test test junk"
+	_, err = config.KeySeed()
+	require.Error(t, err)
+}
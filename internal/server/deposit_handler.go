@@ -1,8 +1,15 @@
 package server
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"math/big"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -11,7 +18,10 @@ import (
 	"github.com/umbracle/ethgo/contract"
 	"github.com/umbracle/ethgo/jsonrpc"
 	"github.com/umbracle/ethgo/wallet"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
 	"github.com/umbracle/go-eth-consensus/deposit"
+	"github.com/umbracle/viewpoint/internal/components"
 	"github.com/umbracle/viewpoint/internal/server/proto"
 )
 
@@ -20,7 +30,17 @@ type depositHandler struct {
 	deposit ethgo.Address
 	client  *jsonrpc.Client
 	key     *wallet.Key
-	nonce   int64
+
+	// nonce is the last nonce used by the key. nonceLock serializes the
+	// transactions of the key to keep their nonces without gaps.
+	nonce     int64
+	nonceLock sync.Mutex
+
+	// forkVersion is the genesis fork version used to sign the deposits
+	forkVersion [4]byte
+
+	// publish is called with the events of the deposits
+	publish func(event *proto.Event)
 }
 
 func newDepositHandler(eth1Addr string, key *wallet.Key) (*depositHandler, error) {
@@ -33,81 +53,292 @@ func newDepositHandler(eth1Addr string, key *wallet.Key) (*depositHandler, error
 		key:    key,
 		nonce:  -1,
 	}
-	if err := handler.deployDeposit(); err != nil {
+	if err := handler.deployDeposit(context.Background()); err != nil {
 		return nil, err
 	}
 	return handler, nil
 }
 
+// loadDepositHandler creates a deposit handler for an already deployed deposit contract
+func loadDepositHandler(eth1Addr string, key *wallet.Key, depositAddr ethgo.Address, nonce int64) (*depositHandler, error) {
+	provider, err := jsonrpc.NewClient(eth1Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the node might have more transactions than the last persisted nonce
+	pendingNonce, err := provider.Eth().GetNonce(key.Address(), ethgo.Pending)
+	if err != nil {
+		return nil, err
+	}
+	if int64(pendingNonce)-1 > nonce {
+		nonce = int64(pendingNonce) - 1
+	}
+
+	handler := &depositHandler{
+		deposit: depositAddr,
+		client:  provider,
+		key:     key,
+		nonce:   nonce,
+	}
+	return handler, nil
+}
+
 const (
 	defaultGasPrice = 1879048192 // 0x70000000
 	defaultGasLimit = 5242880    // 0x500000
+
+	// transferGasLimit is the gas of a transfer to an account without code
+	transferGasLimit = 21000
+
+	// depositGasLimit is the gas limit of the deposit transactions. The gas used by a deposit
+	// depends on the number of branches of the deposit tree updated, which changes with
+	// the deposit count, and it is below 150k gas for any number of deposits made by the server.
+	depositGasLimit = 200000
 )
 
-func (e *depositHandler) fund(addr ethgo.Address) error {
-	_, err := e.sendTransaction(&ethgo.Transaction{
-		To: &addr,
-		// fund the account with enoung balance to validate and send the transaction
-		Value: ethgo.Ether(deposit.MinGweiAmount + 1),
-	})
-	return err
+var (
+	// depositWorkers is the maximum number of accounts that
+	// sign and send their deposits at the same time
+	depositWorkers = 32
+
+	// resubmitBlocks is the number of blocks without a receipt after which
+	// a transaction is sent again in case the node dropped it
+	resubmitBlocks uint64 = 5
+
+	// receiptTimeoutBlocks is the number of blocks to wait for the receipt of a
+	// transaction since it was sent or the previous transaction was mined
+	receiptTimeoutBlocks uint64 = 120
+
+	// newBlockTimeout is the time to wait for a new block before the
+	// pending transactions time out in case the chain does not advance
+	newBlockTimeout = 2 * time.Minute
+
+	// blockPollInterval is the interval to check for new blocks
+	blockPollInterval = 500 * time.Millisecond
+)
+
+// maxSendAttempts is the number of times a transaction of the
+// handler is sent with a new nonce if the node rejects its nonce
+const maxSendAttempts = 5
+
+// pendingTxn is a transaction sent to the node that is waiting to be mined
+type pendingTxn struct {
+	hash ethgo.Hash
+	raw  []byte
+
+	// pubKey is the validator key of the deposit
+	// transactions, empty for other transactions
+	pubKey string
+
+	// since and sentAt are the block numbers when the transaction
+	// started to wait and when it was sent for the last time
+	since  uint64
+	sentAt uint64
+
+	// included is true if the transaction is in a block but
+	// its receipt was not available yet
+	included bool
+
+	resubmits uint64
+	receipt   *ethgo.Receipt
+}
+
+func (e *depositHandler) fundTxn(addr ethgo.Address, amountInGwei uint64) *ethgo.Transaction {
+	return &ethgo.Transaction{
+		To:  &addr,
+		Gas: transferGasLimit,
+		// fund the account with enough balance to validate and send the transaction
+		Value: new(big.Int).Add(ethgo.Gwei(amountInGwei), ethgo.Ether(1)),
+	}
 }
 
-func (e *depositHandler) getNextNonce() uint64 {
-	return uint64(atomic.AddInt64(&e.nonce, 1))
+func signTxn(txn *ethgo.Transaction, key *wallet.Key) ([]byte, error) {
+	signer := wallet.NewEIP155Signer(1337)
+	signedTxn, err := signer.SignTx(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	return signedTxn.MarshalRLPTo(nil)
+}
+
+// syncNonce sets the nonce of the handler to the pending nonce
+// of the node. It is called with the nonce lock held.
+func (e *depositHandler) syncNonce() error {
+	pendingNonce, err := e.client.Eth().GetNonce(e.key.Address(), ethgo.Pending)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&e.nonce, int64(pendingNonce)-1)
+	return nil
 }
 
-func (e *depositHandler) sendTransaction(txn *ethgo.Transaction) (*ethgo.Receipt, error) {
+// isNonceError returns true if the node rejected the transaction because of its nonce
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce") || strings.Contains(msg, "already known") || strings.Contains(msg, "underpriced")
+}
+
+// submitTransaction signs the transaction with the key of the handler and sends it.
+// The nonces are assigned in order, if the node rejects a transaction the nonce is
+// synced with the node so that there are no gaps for the next transactions.
+func (e *depositHandler) submitTransaction(txn *ethgo.Transaction) (*pendingTxn, error) {
 	if txn.GasPrice == 0 {
 		txn.GasPrice = defaultGasPrice
 	}
 	if txn.Gas == 0 {
 		txn.Gas = defaultGasLimit
 	}
-	if txn.Nonce == 0 {
-		txn.Nonce = e.getNextNonce()
-	}
 
-	signer := wallet.NewEIP155Signer(1337)
-	signedTxn, err := signer.SignTx(txn, e.key)
-	if err != nil {
-		return nil, err
+	e.nonceLock.Lock()
+	defer e.nonceLock.Unlock()
+
+	for attempt := 1; ; attempt++ {
+		txn.Nonce = uint64(atomic.AddInt64(&e.nonce, 1))
+
+		raw, err := signTxn(txn, e.key)
+		if err != nil {
+			atomic.AddInt64(&e.nonce, -1)
+			return nil, err
+		}
+		hash, err := e.client.Eth().SendRawTransaction(raw)
+		if err == nil {
+			return &pendingTxn{hash: hash, raw: raw}, nil
+		}
+
+		// the nonce was not used
+		if syncErr := e.syncNonce(); syncErr != nil {
+			return nil, fmt.Errorf("failed to sync nonce after '%v': %v", err, syncErr)
+		}
+		if !isNonceError(err) || attempt == maxSendAttempts {
+			return nil, err
+		}
 	}
+}
 
-	txnRaw, err := signedTxn.MarshalRLPTo(nil)
+func (e *depositHandler) sendTransaction(ctx context.Context, txn *ethgo.Transaction) (*ethgo.Receipt, error) {
+	from, err := e.client.Eth().BlockNumber()
 	if err != nil {
 		return nil, err
 	}
-	hash, err := e.client.Eth().SendRawTransaction(txnRaw)
+	pending, err := e.submitTransaction(txn)
 	if err != nil {
 		return nil, err
 	}
-	receipt, err := e.waitForReceipt(hash)
-	if err != nil {
+	if err := e.waitForReceipts(ctx, []*pendingTxn{pending}, from, nil); err != nil {
 		return nil, err
 	}
-	return receipt, nil
+	return pending.receipt, nil
 }
 
-func (e *depositHandler) waitForReceipt(hash ethgo.Hash) (*ethgo.Receipt, error) {
-	var count uint64
-	for {
-		receipt, err := e.client.Eth().GetTransactionReceipt(hash)
+// waitForReceipts waits until all the transactions sent after the block from are mined.
+// The transactions of every new block are read and only the receipts of the pending
+// transactions in the block are queried. The transactions are ordered as they were
+// sent and the node mines them in that order when the blocks are full, then a transaction
+// times out or it is sent again after some blocks since it was sent or since the
+// previous transaction was mined. onBlock is called after every new block. It stops
+// when the context is done or when there are no new blocks after newBlockTimeout.
+func (e *depositHandler) waitForReceipts(ctx context.Context, txns []*pendingTxn, from uint64, onBlock func()) error {
+	for _, txn := range txns {
+		txn.since, txn.sentAt = from, from
+	}
+	pending := txns
+
+	lastBlock := from
+	lastBlockAt := time.Now()
+	for len(pending) != 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		num, err := e.client.Eth().BlockNumber()
 		if err != nil {
-			if err.Error() != "not found" {
-				return nil, err
+			return err
+		}
+		if num <= lastBlock {
+			if time.Since(lastBlockAt) > newBlockTimeout {
+				return fmt.Errorf("no new blocks after %s with %d transactions pending", newBlockTimeout, len(pending))
 			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(blockPollInterval):
+			}
+			continue
 		}
-		if receipt != nil {
-			return receipt, nil
+		lastBlockAt = time.Now()
+
+		mined := map[ethgo.Hash]struct{}{}
+		for i := lastBlock + 1; i <= num; i++ {
+			block, err := e.client.Eth().GetBlockByNumber(ethgo.BlockNumber(i), false)
+			if err != nil {
+				return err
+			}
+			if block == nil {
+				return fmt.Errorf("block %d not found", i)
+			}
+			for _, hash := range block.TransactionsHashes {
+				mined[hash] = struct{}{}
+			}
+		}
+		lastBlock = num
+
+		// queued is the last block with a mined transaction
+		// that was sent before the next pending transactions
+		var queued uint64
+
+		remaining := []*pendingTxn{}
+		for _, txn := range pending {
+			if _, ok := mined[txn.hash]; ok || txn.included {
+				receipt, err := e.client.Eth().GetTransactionReceipt(txn.hash)
+				if err != nil {
+					return err
+				}
+				if receipt != nil {
+					txn.receipt = receipt
+					if receipt.BlockNumber > queued {
+						queued = receipt.BlockNumber
+					}
+					if txn.pubKey != "" {
+						e.emit(&proto.Event{
+							Event: &proto.Event_DepositMined_{
+								DepositMined: &proto.Event_DepositMined{
+									PubKey:      txn.pubKey,
+									TxnHash:     txn.hash.String(),
+									BlockNumber: receipt.BlockNumber,
+								},
+							},
+						})
+					}
+					continue
+				}
+				txn.included = true
+			}
+
+			if queued > txn.since {
+				txn.since = queued
+			}
+			if queued > txn.sentAt {
+				txn.sentAt = queued
+			}
+			if num-txn.since > receiptTimeoutBlocks {
+				return fmt.Errorf("transaction %s not mined after %d blocks", txn.hash, receiptTimeoutBlocks)
+			}
+			if !txn.included && num-txn.sentAt >= resubmitBlocks {
+				// the node might have dropped the transaction. The error is not
+				// relevant since the node also fails if it still has it.
+				e.client.Eth().SendRawTransaction(txn.raw)
+				txn.sentAt = num
+				txn.resubmits++
+			}
+			remaining = append(remaining, txn)
 		}
-		if count > 120 {
-			break
+		pending = remaining
+
+		if onBlock != nil {
+			onBlock()
 		}
-		time.Sleep(1 * time.Second)
-		count++
 	}
-	return nil, fmt.Errorf("timeout")
+	return nil
 }
 
 // Provider returns the jsonrpc provider
@@ -116,8 +347,8 @@ func (e *depositHandler) Provider() *jsonrpc.Client {
 }
 
 // DeployDeposit deploys the eth2.0 deposit contract
-func (e *depositHandler) deployDeposit() error {
-	receipt, err := e.sendTransaction(&ethgo.Transaction{
+func (e *depositHandler) deployDeposit(ctx context.Context) error {
+	receipt, err := e.sendTransaction(ctx, &ethgo.Transaction{
 		Input: deposit.DepositBin(),
 	})
 	if err != nil {
@@ -127,6 +358,45 @@ func (e *depositHandler) deployDeposit() error {
 	return nil
 }
 
+// genesisDepositContract is the address of the deposit contract
+// when it is part of the genesis of the execution chain
+var genesisDepositContract = ethgo.HexToAddress("0x4242424242424242424242424242424242424242")
+
+// depositTreeDepth is the depth of the merkle tree of deposits in the contract
+const depositTreeDepth = 32
+
+// depositContractGenesis returns the deposit contract as it is right after
+// its deployment to include it in the genesis of the execution chain. This is
+// required when the chain starts merged since there are no blocks to deploy it.
+func depositContractGenesis() (*components.GenesisContract, error) {
+	// the runtime code follows the constructor in the creation code, which
+	// ends copying it to memory and returning it (CODECOPY, RETURN, INVALID)
+	bin := deposit.DepositBin()
+	indx := bytes.Index(bin, []byte{0x60, 0x00, 0x39, 0x60, 0x00, 0xf3, 0xfe})
+	if indx == -1 {
+		return nil, fmt.Errorf("runtime code of the deposit contract not found")
+	}
+	code := bin[indx+7:]
+
+	// the constructor fills the 'zero_hashes' array, which is stored
+	// after the 'branch' array and the 'deposit_count' counter
+	storage := map[ethgo.Hash]ethgo.Hash{}
+	zeroHash := [32]byte{}
+	for i := 1; i < depositTreeDepth; i++ {
+		zeroHash = sha256.Sum256(append(zeroHash[:], zeroHash[:]...))
+
+		var slot ethgo.Hash
+		binary.BigEndian.PutUint64(slot[24:], uint64(depositTreeDepth+1+i))
+		storage[slot] = zeroHash
+	}
+
+	contract := &components.GenesisContract{
+		Code:    code,
+		Storage: storage,
+	}
+	return contract, nil
+}
+
 func (e *depositHandler) Deposit() ethgo.Address {
 	return e.deposit
 }
@@ -144,21 +414,246 @@ func (e *depositHandler) GetDepositCount() (uint32, error) {
 	return binary.LittleEndian.Uint32(count), nil
 }
 
-// MakeDeposits deposits the minimum required value to become a validator to multiple accounts
-func (e *depositHandler) MakeDeposits(accounts []*proto.Account) error {
+// minDepositAmount is the minimum deposit in gwei accepted by the deposit contract
+const minDepositAmount = 1000000000
+
+// depositOpts are the parameters of the deposits
+type depositOpts struct {
+	// amount is the value of each deposit in gwei
+	amount uint64
+
+	// credentials is the type of the withdrawal credentials and
+	// withdrawalAddress the address of the execution credentials
+	credentials       proto.WithdrawalCredentials
+	withdrawalAddress ethgo.Address
+
+	// invalid is the kind of invalid deposits to make
+	invalid proto.InvalidDeposit
+}
+
+// defaultDepositOpts returns the deposits required to become a validator
+// with the BLS withdrawal credentials of the validator key
+func defaultDepositOpts() *depositOpts {
+	return &depositOpts{
+		amount: ethgo.Gwei(deposit.MinGweiAmount).Uint64(),
+	}
+}
+
+// withdrawalCredentials returns the withdrawal credentials of the deposit of the key
+func (d *depositOpts) withdrawalCredentials(key *bls.Key) [32]byte {
+	var credentials [32]byte
+	if d.credentials == proto.WithdrawalCredentials_ExecutionCredentials {
+		credentials[0] = 0x01
+		copy(credentials[12:], d.withdrawalAddress[:])
+	} else {
+		pub := key.PubKey()
+		credentials = sha256.Sum256(pub[:])
+		credentials[0] = 0x00
+	}
+	return credentials
+}
+
+// forEachAccount calls the handler for every account with at most depositWorkers
+// accounts at the same time. It returns the first error of the handler.
+func forEachAccount(accounts []*proto.Account, handler func(indx int, acct *proto.Account) error) error {
+	workers := depositWorkers
+	if len(accounts) < workers {
+		workers = len(accounts)
+	}
+
+	var failed int32
+	indxCh := make(chan int)
 	errCh := make(chan error, len(accounts))
-	for _, acct := range accounts {
-		go func(acct *proto.Account) {
-			errCh <- e.MakeDeposit(acct)
-		}(acct)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for indx := range indxCh {
+				if atomic.LoadInt32(&failed) == 1 {
+					continue
+				}
+				if err := handler(indx, accounts[indx]); err != nil {
+					atomic.StoreInt32(&failed, 1)
+					errCh <- err
+				}
+			}
+		}()
+	}
+	for indx := range accounts {
+		indxCh <- indx
+	}
+	close(indxCh)
+	wg.Wait()
+
+	close(errCh)
+	return <-errCh
+}
+
+// MakeDeposits deposits the value of the options to multiple accounts and returns
+// the deposits sent in the order of the accounts. The accounts are funded in a batch
+// and then they send their deposits with bounded concurrency. The progress is
+// published as events. If it fails after some deposits were sent, those deposits are
+// returned with the error since they might still be processed by the chain.
+func (e *depositHandler) MakeDeposits(ctx context.Context, accounts []*proto.Account, opts *depositOpts) ([]*proto.DepositStub, error) {
+	txns := make([][]*depositTxn, len(accounts))
+	err := forEachAccount(accounts, func(indx int, acct *proto.Account) error {
+		var err error
+		txns[indx], err = e.buildDeposits(acct, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	for i := 0; i < len(accounts); i++ {
-		if err := <-errCh; err != nil {
+	progress := &proto.Event_DepositProgress{
+		NumAccounts: uint64(len(accounts)),
+	}
+	for _, t := range txns {
+		progress.NumDeposits += uint64(len(t))
+	}
+
+	// fund the owner addresses of the deposits
+	from, err := e.client.Eth().BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	funding := []*pendingTxn{}
+	for indx, acct := range accounts {
+		var amount uint64
+		for _, txn := range txns[indx] {
+			amount += txn.data.Amount
+		}
+		pending, err := e.submitTransaction(e.fundTxn(acct.Ecdsa.Address(), amount))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fund account: %v", err)
+		}
+		funding = append(funding, pending)
+	}
+	err = e.waitForReceipts(ctx, funding, from, func() {
+		progress.Funded, progress.Resubmitted = countReceipts(funding)
+		e.emitProgress(progress)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, pending := range funding {
+		if pending.receipt.Status != 1 {
+			return nil, fmt.Errorf("funding transaction %s failed", pending.hash)
+		}
+	}
+	fundResubmits := progress.Resubmitted
+
+	// the deposit transactions are not local to the node and require its gas price
+	gasPrice, err := e.client.Eth().GasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	if from, err = e.client.Eth().BlockNumber(); err != nil {
+		return nil, err
+	}
+	sent := make([][]*pendingTxn, len(accounts))
+	err = forEachAccount(accounts, func(indx int, acct *proto.Account) error {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
+		nonce, err := e.client.Eth().GetNonce(acct.Ecdsa.Address(), ethgo.Pending)
+		if err != nil {
+			return err
+		}
+		for i, txn := range txns[indx] {
+			pending, err := e.submitDeposit(acct, txn.data, nonce+uint64(i), gasPrice)
+			if err != nil {
+				return err
+			}
+			sent[indx] = append(sent[indx], pending)
+		}
+		return nil
+	})
+	if err != nil {
+		return depositStubs(txns, sent, opts), err
+	}
+
+	deposits := []*pendingTxn{}
+	for _, pending := range sent {
+		deposits = append(deposits, pending...)
+	}
+	progress.Sent = uint64(len(deposits))
+	e.emitProgress(progress)
+
+	err = e.waitForReceipts(ctx, deposits, from, func() {
+		var resubmits uint64
+		progress.Mined, resubmits = countReceipts(deposits)
+		progress.Resubmitted = fundResubmits + resubmits
+		e.emitProgress(progress)
+	})
+	res := depositStubs(txns, sent, opts)
+	if err != nil {
+		return res, err
+	}
+
+	// the deposit contract reverts the deposits with an invalid root or amount
+	expectRevert := opts.invalid == proto.InvalidDeposit_InvalidRoot || opts.invalid == proto.InvalidDeposit_InvalidAmount
+	for _, pending := range deposits {
+		if err := checkDepositReceipt(pending.receipt, expectRevert); err != nil {
+			return res, fmt.Errorf("deposit %s: %v", pending.hash, err)
+		}
+	}
+	return res, nil
+}
+
+// depositStubs returns the deposits sent in the order of the accounts
+func depositStubs(txns [][]*depositTxn, sent [][]*pendingTxn, opts *depositOpts) []*proto.DepositStub {
+	res := []*proto.DepositStub{}
+	for indx := range sent {
+		for i, pending := range sent[indx] {
+			txn := txns[indx][i]
+
+			res = append(res, &proto.DepositStub{
+				PubKey:                pending.pubKey,
+				Amount:                txn.data.Amount,
+				WithdrawalCredentials: hex.EncodeToString(txn.data.WithdrawalCredentials[:]),
+				TxnHash:               pending.hash.String(),
+				Invalid:               opts.invalid,
+				Rejected:              txn.rejected,
+			})
+		}
+	}
+	return res
+}
+
+// countReceipts returns the number of mined transactions and the times they were resubmitted
+func countReceipts(txns []*pendingTxn) (mined uint64, resubmits uint64) {
+	for _, txn := range txns {
+		if txn.receipt != nil {
+			mined++
+		}
+		resubmits += txn.resubmits
+	}
+	return
+}
+
+func (e *depositHandler) emitProgress(progress *proto.Event_DepositProgress) {
+	e.emit(&proto.Event{
+		Event: &proto.Event_DepositProgress_{
+			DepositProgress: &proto.Event_DepositProgress{
+				NumAccounts: progress.NumAccounts,
+				NumDeposits: progress.NumDeposits,
+				Funded:      progress.Funded,
+				Sent:        progress.Sent,
+				Mined:       progress.Mined,
+				Resubmitted: progress.Resubmitted,
+			},
+		},
+	})
+}
+
+func (e *depositHandler) emit(event *proto.Event) {
+	if e.publish != nil {
+		e.publish(event)
 	}
-	return nil
 }
 
 var depositEvent = abi.MustNewEvent(`event DepositEvent(
@@ -169,41 +664,192 @@ var depositEvent = abi.MustNewEvent(`event DepositEvent(
 	bytes index
 )`)
 
-// MakeDeposit deposits the minimum required value to become a validator
-func (e *depositHandler) MakeDeposit(account *proto.Account) error {
-	depositAmount := deposit.MinGweiAmount
+// domainDeposit is the signature domain of the deposits
+var domainDeposit = consensus.Domain{0x03, 0x00, 0x00, 0x00}
 
-	// fund the owner address
-	if err := e.fund(account.Ecdsa.Address()); err != nil {
-		return err
+// signDeposit creates the deposit data for the key. The deposits are signed with
+// the genesis fork version of the network and an empty genesis validators root.
+func signDeposit(key *bls.Key, amountInGwei uint64, credentials [32]byte, forkVersion [4]byte) (*consensus.DepositData, error) {
+	domain, err := consensus.ComputeDomain(domainDeposit, forkVersion, consensus.Root{})
+	if err != nil {
+		return nil, err
 	}
 
-	data, err := deposit.Input(account.Bls, nil, ethgo.Gwei(depositAmount).Uint64())
+	msg := &consensus.DepositMessage{
+		Pubkey:                key.Pub.Serialize(),
+		Amount:                amountInGwei,
+		WithdrawalCredentials: credentials,
+	}
+	msgRoot, err := msg.HashTreeRoot()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	signingData := &consensus.SigningData{
+		ObjectRoot: msgRoot,
+		Domain:     domain,
+	}
+	rootToSign, err := signingData.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.Sign(rootToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &consensus.DepositData{
+		Pubkey:                msg.Pubkey,
+		Amount:                amountInGwei,
+		WithdrawalCredentials: msg.WithdrawalCredentials,
+		Signature:             signature,
+	}
+	if data.Root, err = data.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// depositTxn is a deposit to send to the deposit contract
+type depositTxn struct {
+	data *consensus.DepositData
+
+	// rejected is true if the deposit is not expected
+	// to activate the validator with its credentials
+	rejected bool
+}
+
+// buildDeposits returns the deposits of the account. There might be more than
+// one deposit or invalid ones depending on the kind of invalid deposits.
+func (e *depositHandler) buildDeposits(account *proto.Account, opts *depositOpts) ([]*depositTxn, error) {
+	credentials := opts.withdrawalCredentials(account.Bls)
+
+	switch opts.invalid {
+	case proto.InvalidDeposit_InvalidSignature:
+		// sign the deposit of the account with another key
+		data, err := signDeposit(bls.NewRandomKey(), opts.amount, credentials, e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+		data.Pubkey = account.Bls.Pub.Serialize()
+		if data.Root, err = data.HashTreeRoot(); err != nil {
+			return nil, err
+		}
+		return []*depositTxn{{data: data, rejected: true}}, nil
+
+	case proto.InvalidDeposit_InvalidRoot:
+		data, err := signDeposit(account.Bls, opts.amount, credentials, e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+		data.Root[0] ^= 0xff
+		return []*depositTxn{{data: data, rejected: true}}, nil
+
+	case proto.InvalidDeposit_InvalidDuplicate:
+		first, err := signDeposit(account.Bls, opts.amount, credentials, e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		// the second deposit is a top up and its credentials are ignored
+		other := *opts
+		if opts.credentials == proto.WithdrawalCredentials_ExecutionCredentials {
+			other.credentials = proto.WithdrawalCredentials_BlsCredentials
+		} else {
+			other.credentials = proto.WithdrawalCredentials_ExecutionCredentials
+			other.withdrawalAddress = account.Ecdsa.Address()
+		}
+		second, err := signDeposit(account.Bls, opts.amount, other.withdrawalCredentials(account.Bls), e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []*depositTxn{{data: first}, {data: second, rejected: true}}, nil
+
+	case proto.InvalidDeposit_InvalidAmount:
+		// the deposit contract reverts the deposits lower than its minimum
+		data, err := signDeposit(account.Bls, minDepositAmount-1, credentials, e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []*depositTxn{{data: data, rejected: true}}, nil
+
+	default:
+		data, err := signDeposit(account.Bls, opts.amount, credentials, e.forkVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []*depositTxn{{data: data}}, nil
 	}
+}
 
-	depositC := deposit.NewDeposit(e.deposit, contract.WithSender(account.Ecdsa), contract.WithJsonRPC(e.Provider().Eth()))
+// MakeDeposit deposits the value of the options to an account. The deposit
+// tops up the balance if the account is already a validator.
+func (e *depositHandler) MakeDeposit(ctx context.Context, account *proto.Account, opts *depositOpts) ([]*proto.DepositStub, error) {
+	return e.MakeDeposits(ctx, []*proto.Account{account}, opts)
+}
 
-	txn, err := depositC.Deposit(data.Pubkey[:], data.WithdrawalCredentials[:], data.Signature[:], data.Root)
+// depositMethod is the method of the deposit contract to make a deposit
+var depositMethod = func() *abi.Method {
+	method, err := abi.NewMethod("function deposit(bytes pubkey, bytes withdrawal_credentials, bytes signature, bytes32 deposit_data_root)")
 	if err != nil {
-		return err
+		panic(err)
 	}
-	// gas limit must be hardcoded since the estimate gas limit might not be enough with multiple
-	// async deposits (small changes in the smart contract change the estimation).
-	txn.WithOpts(&contract.TxnOpts{GasLimit: defaultGasLimit, Value: ethgo.Ether(depositAmount)})
+	return method
+}()
 
-	if err := txn.Do(); err != nil {
-		return err
+// submitDeposit signs the deposit transaction of the account with the nonce and sends it
+func (e *depositHandler) submitDeposit(account *proto.Account, data *consensus.DepositData, nonce uint64, gasPrice uint64) (*pendingTxn, error) {
+	input, err := depositMethod.Encode([]interface{}{
+		data.Pubkey[:],
+		data.WithdrawalCredentials[:],
+		data.Signature[:],
+		data.Root,
+	})
+	if err != nil {
+		return nil, err
+	}
+	txn := &ethgo.Transaction{
+		To:       &e.deposit,
+		Input:    input,
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		// gas limit must be hardcoded since the estimate gas limit might not be enough with multiple
+		// async deposits (small changes in the smart contract change the estimation).
+		Gas:   depositGasLimit,
+		Value: ethgo.Gwei(data.Amount),
 	}
-	receipt, err := txn.Wait()
+	raw, err := signTxn(txn, account.Ecdsa)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	hash, err := e.client.Eth().SendRawTransaction(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := hex.EncodeToString(data.Pubkey[:])
+	e.emit(&proto.Event{
+		Event: &proto.Event_DepositSent_{
+			DepositSent: &proto.Event_DepositSent{
+				PubKey:  pubKey,
+				TxnHash: hash.String(),
+			},
+		},
+	})
+	return &pendingTxn{hash: hash, raw: raw, pubKey: pubKey}, nil
+}
+
+// checkDepositReceipt checks that the deposit emitted the deposit event or, if
+// expectRevert is set, that it was reverted by the contract
+func checkDepositReceipt(receipt *ethgo.Receipt, expectRevert bool) error {
+	if expectRevert {
+		if receipt.Status == 1 {
+			return fmt.Errorf("deposit was not reverted")
+		}
+		return nil
 	}
 	if len(receipt.Logs) != 1 {
 		return fmt.Errorf("log not found")
 	}
-
 	if _, err := depositEvent.ParseLog(receipt.Logs[0]); err != nil {
 		return err
 	}
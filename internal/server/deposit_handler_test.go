@@ -1,12 +1,27 @@
 package server
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
 	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/wallet"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/go-eth-consensus/deposit"
 	"github.com/umbracle/viewpoint/internal/components"
 	"github.com/umbracle/viewpoint/internal/docker"
 	"github.com/umbracle/viewpoint/internal/server/proto"
@@ -55,11 +70,655 @@ func TestDepositHandler_Deposit(t *testing.T) {
 		for j := 0; j < numAccounts; j++ {
 			accounts[j] = proto.NewAccount()
 		}
-		err = handler.MakeDeposits(accounts)
+		_, err = handler.MakeDeposits(context.Background(), accounts, defaultDepositOpts())
 		assert.NoError(t, err)
 	}
 
 	count, err := handler.GetDepositCount()
 	assert.NoError(t, err)
 	assert.Equal(t, count, uint32(round*numAccounts))
+
+	// invalid deposits
+	kinds := map[proto.InvalidDeposit]int{
+		proto.InvalidDeposit_InvalidSignature: 1,
+		proto.InvalidDeposit_InvalidRoot:      0,
+		proto.InvalidDeposit_InvalidDuplicate: 2,
+		proto.InvalidDeposit_InvalidAmount:    0,
+	}
+	for kind, num := range kinds {
+		opts := defaultDepositOpts()
+		opts.invalid = kind
+
+		deposits, err := handler.MakeDeposit(context.Background(), proto.NewAccount(), opts)
+		require.NoError(t, err)
+		assert.True(t, deposits[len(deposits)-1].Rejected)
+
+		newCount, err := handler.GetDepositCount()
+		require.NoError(t, err)
+		assert.Equal(t, count+uint32(num), newCount, kind.String())
+		count = newCount
+	}
+}
+
+func TestDepositHandler_GenesisContract(t *testing.T) {
+	contract, err := depositContractGenesis()
+	require.NoError(t, err)
+
+	// runtime code without the constructor
+	assert.Len(t, contract.Code, 6358)
+	assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, contract.Code[:5])
+
+	// zero_hashes[1] is stored in the slot 34
+	assert.Len(t, contract.Storage, 31)
+	assert.Equal(t,
+		"0xf5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b",
+		contract.Storage[ethgo.BytesToHash([]byte{34})].String())
+}
+
+func TestDepositHandler_SignDeposit(t *testing.T) {
+	key := bls.NewRandomKey()
+
+	// with the default genesis fork version it matches the deposits
+	// of the go-eth-consensus library
+	expected, err := deposit.Input(key, nil, 32000000000)
+	require.NoError(t, err)
+
+	data, err := signDeposit(key, 32000000000, [32]byte{}, [4]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, expected.Signature, data.Signature)
+	assert.Equal(t, expected.Root, data.Root)
+
+	// a different fork version changes the signature
+	other, err := signDeposit(key, 32000000000, [32]byte{}, [4]byte{0x10, 0x0, 0x0, 0x0})
+	require.NoError(t, err)
+	assert.NotEqual(t, data.Signature, other.Signature)
+}
+
+func TestDepositHandler_WithdrawalCredentials(t *testing.T) {
+	key := bls.NewRandomKey()
+
+	// bls credentials with the hash of the public key
+	opts := defaultDepositOpts()
+	pub := key.PubKey()
+	hash := sha256.Sum256(pub[:])
+
+	credentials := opts.withdrawalCredentials(key)
+	assert.Equal(t, byte(0x00), credentials[0])
+	assert.Equal(t, hash[1:], credentials[1:])
+
+	// execution credentials with the address
+	opts.credentials = proto.WithdrawalCredentials_ExecutionCredentials
+	opts.withdrawalAddress = ethgo.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	credentials = opts.withdrawalCredentials(key)
+	assert.Equal(t, "0x0100000000000000000000001234567890123456789012345678901234567890", "0x"+hex.EncodeToString(credentials[:]))
+
+	// the credentials are part of the signed deposit
+	data, err := signDeposit(key, 32000000000, credentials, [4]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, credentials, data.WithdrawalCredentials)
+}
+
+func TestDepositOptsFromRequest(t *testing.T) {
+	opts, err := depositOptsFromRequest(&proto.DepositCreateRequest{NumValidators: 1})
+	require.NoError(t, err)
+	assert.Equal(t, defaultDepositOpts(), opts)
+
+	opts, err = depositOptsFromRequest(&proto.DepositCreateRequest{
+		Amount:                1000000000,
+		WithdrawalCredentials: proto.WithdrawalCredentials_ExecutionCredentials,
+		WithdrawalAddress:     "0x1234567890123456789012345678901234567890",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000000000), opts.amount)
+	assert.Equal(t, ethgo.HexToAddress("0x1234567890123456789012345678901234567890"), opts.withdrawalAddress)
+
+	cases := []*proto.DepositCreateRequest{
+		// lower than the minimum deposit
+		{Amount: 100},
+		// execution credentials without address
+		{WithdrawalCredentials: proto.WithdrawalCredentials_ExecutionCredentials},
+		// invalid address
+		{WithdrawalCredentials: proto.WithdrawalCredentials_ExecutionCredentials, WithdrawalAddress: "0x1234"},
+		// address with bls credentials
+		{WithdrawalAddress: "0x1234567890123456789012345678901234567890"},
+		// credentials on a top up
+		{TopUp: true, WithdrawalCredentials: proto.WithdrawalCredentials_ExecutionCredentials},
+		// validators on a top up
+		{TopUp: true, NumValidators: 1},
+		// invalid deposits on a top up
+		{TopUp: true, Invalid: proto.InvalidDeposit_InvalidSignature},
+		// amount with invalid amount deposits
+		{Amount: 1000000000, Invalid: proto.InvalidDeposit_InvalidAmount},
+		// unknown invalid deposit
+		{Invalid: 100},
+	}
+	for _, c := range cases {
+		_, err := depositOptsFromRequest(c)
+		require.Error(t, err)
+	}
+}
+
+func TestDepositHandler_BuildInvalidDeposits(t *testing.T) {
+	handler := &depositHandler{}
+	account := proto.NewAccount()
+
+	build := func(invalid proto.InvalidDeposit) []*depositTxn {
+		opts := defaultDepositOpts()
+		opts.invalid = invalid
+
+		txns, err := handler.buildDeposits(account, opts)
+		require.NoError(t, err)
+		return txns
+	}
+
+	valid := build(proto.InvalidDeposit_NoInvalidDeposit)
+	require.Len(t, valid, 1)
+	assert.False(t, valid[0].rejected)
+
+	// the signature is not valid for the public key
+	txns := build(proto.InvalidDeposit_InvalidSignature)
+	require.Len(t, txns, 1)
+	assert.True(t, txns[0].rejected)
+	assert.Equal(t, valid[0].data.Pubkey, txns[0].data.Pubkey)
+	assert.NotEqual(t, valid[0].data.Signature, txns[0].data.Signature)
+
+	root, err := txns[0].data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, txns[0].data.Root)
+
+	// the root does not match the deposit
+	txns = build(proto.InvalidDeposit_InvalidRoot)
+	require.Len(t, txns, 1)
+	assert.True(t, txns[0].rejected)
+	assert.Equal(t, valid[0].data.Signature, txns[0].data.Signature)
+	assert.NotEqual(t, valid[0].data.Root, txns[0].data.Root)
+
+	// the second deposit uses execution credentials
+	txns = build(proto.InvalidDeposit_InvalidDuplicate)
+	require.Len(t, txns, 2)
+	assert.False(t, txns[0].rejected)
+	assert.True(t, txns[1].rejected)
+	assert.Equal(t, valid[0].data.WithdrawalCredentials, txns[0].data.WithdrawalCredentials)
+	assert.Equal(t, byte(0x01), txns[1].data.WithdrawalCredentials[0])
+
+	// the amount is lower than the minimum of the deposit contract
+	txns = build(proto.InvalidDeposit_InvalidAmount)
+	require.Len(t, txns, 1)
+	assert.True(t, txns[0].rejected)
+	assert.Equal(t, uint64(minDepositAmount-1), txns[0].data.Amount)
+}
+
+// testEth1 is an execution node with the JSON-RPC endpoints used by the deposit handler.
+// A block is mined every time the block number is queried with the pending transactions
+// that follow the nonce of their sender and fit in the gas limit of the block.
+type testEth1 struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	block    uint64
+	nonces   map[ethgo.Address]uint64
+	pool     map[ethgo.Hash]*ethgo.Transaction
+	receipts map[ethgo.Hash]map[string]interface{}
+	blocks   map[uint64][]ethgo.Hash
+
+	// gasLimit is the gas limit of the blocks
+	gasLimit uint64
+	// receiptCalls is the number of receipts queried
+	receiptCalls int
+
+	// sendErrs are returned by the next transactions sent
+	sendErrs []string
+	// drop returns true if the transaction is dropped by the node
+	drop func(txn *ethgo.Transaction, from ethgo.Address) bool
+	// sends is the number of transactions sent
+	sends int
+	// halted stops the chain from producing new blocks
+	halted bool
+}
+
+func newTestEth1(t *testing.T) *testEth1 {
+	e := &testEth1{
+		nonces:   map[ethgo.Address]uint64{},
+		pool:     map[ethgo.Hash]*ethgo.Transaction{},
+		receipts: map[ethgo.Hash]map[string]interface{}{},
+		blocks:   map[uint64][]ethgo.Hash{},
+		// gas limit of the genesis of the execution node
+		gasLimit: 0xffffff,
+	}
+	e.Server = httptest.NewServer(http.HandlerFunc(e.handle))
+	t.Cleanup(e.Close)
+	return e
+}
+
+func (e *testEth1) handle(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e.lock.Lock()
+	result, err := e.call(req.Method, req.Params)
+	e.lock.Unlock()
+
+	resp := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+	}
+	if err != nil {
+		resp["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+	} else {
+		resp["result"] = result
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (e *testEth1) call(method string, params []json.RawMessage) (interface{}, error) {
+	switch method {
+	case "eth_blockNumber":
+		if !e.halted {
+			e.mine()
+		}
+		return fmt.Sprintf("0x%x", e.block), nil
+
+	case "eth_gasPrice":
+		return "0x1", nil
+
+	case "eth_getTransactionCount":
+		var addr ethgo.Address
+		if err := json.Unmarshal(params[0], &addr); err != nil {
+			return nil, err
+		}
+		nonce := e.nonces[addr]
+		for {
+			found := false
+			for _, txn := range e.pool {
+				if txn.From == addr && txn.Nonce == nonce {
+					found = true
+				}
+			}
+			if !found {
+				break
+			}
+			nonce++
+		}
+		return fmt.Sprintf("0x%x", nonce), nil
+
+	case "eth_sendRawTransaction":
+		var raw string
+		if err := json.Unmarshal(params[0], &raw); err != nil {
+			return nil, err
+		}
+		buf, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		txn := &ethgo.Transaction{}
+		if err := txn.UnmarshalRLP(buf); err != nil {
+			return nil, err
+		}
+		if txn.From, err = wallet.NewEIP155Signer(1337).RecoverSender(txn); err != nil {
+			return nil, err
+		}
+		hash := ethgo.BytesToHash(ethgo.Keccak256(buf))
+
+		e.sends++
+		if len(e.sendErrs) != 0 {
+			msg := e.sendErrs[0]
+			e.sendErrs = e.sendErrs[1:]
+			return nil, fmt.Errorf(msg)
+		}
+		if _, ok := e.pool[hash]; ok {
+			return nil, fmt.Errorf("already known")
+		}
+		if txn.Nonce < e.nonces[txn.From] {
+			return nil, fmt.Errorf("nonce too low")
+		}
+		if txn.Gas > e.gasLimit {
+			return nil, fmt.Errorf("exceeds block gas limit")
+		}
+		if e.drop == nil || !e.drop(txn, txn.From) {
+			e.pool[hash] = txn
+		}
+		return hash.String(), nil
+
+	case "eth_getTransactionReceipt":
+		var hash ethgo.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+		e.receiptCalls++
+		receipt, ok := e.receipts[hash]
+		if !ok {
+			return nil, nil
+		}
+		return receipt, nil
+
+	case "eth_getBlockByNumber":
+		var num string
+		if err := json.Unmarshal(params[0], &num); err != nil {
+			return nil, err
+		}
+		block, err := strconv.ParseUint(strings.TrimPrefix(num, "0x"), 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if block > e.block {
+			return nil, nil
+		}
+		txns := []string{}
+		for _, hash := range e.blocks[block] {
+			txns = append(txns, hash.String())
+		}
+		return map[string]interface{}{
+			"number":           num,
+			"hash":             ethgo.Hash{0x1}.String(),
+			"parentHash":       ethgo.Hash{}.String(),
+			"sha3Uncles":       ethgo.Hash{}.String(),
+			"transactionsRoot": ethgo.Hash{}.String(),
+			"stateRoot":        ethgo.Hash{}.String(),
+			"receiptsRoot":     ethgo.Hash{}.String(),
+			"miner":            ethgo.Address{}.String(),
+			"gasLimit":         fmt.Sprintf("0x%x", e.gasLimit),
+			"gasUsed":          "0x0",
+			"timestamp":        "0x0",
+			"difficulty":       "0x0",
+			"extraData":        "0x",
+			"transactions":     txns,
+			"uncles":           []string{},
+		}, nil
+	}
+	return nil, fmt.Errorf("method %s not found", method)
+}
+
+// mine includes in a new block the transactions of the pool that follow
+// the nonce of their sender until the block reaches its gas limit
+func (e *testEth1) mine() {
+	e.block++
+
+	var gasUsed uint64
+	for {
+		found := false
+		for hash, txn := range e.pool {
+			if txn.Nonce != e.nonces[txn.From] || gasUsed+txn.Gas > e.gasLimit {
+				continue
+			}
+			gasUsed += txn.Gas
+			e.blocks[e.block] = append(e.blocks[e.block], hash)
+			e.nonces[txn.From]++
+			delete(e.pool, hash)
+			found = true
+
+			// the deposit contract reverts the deposits lower than 1 ether
+			status := "0x1"
+			reverted := len(txn.Input) != 0 && (txn.Value == nil || txn.Value.Cmp(ethgo.Ether(1)) < 0)
+			if reverted {
+				status = "0x0"
+			}
+
+			logs := []*ethgo.Log{}
+			if len(txn.Input) != 0 && !reverted {
+				data, err := abi.Encode(map[string]interface{}{
+					"pubkey":                 []byte{},
+					"withdrawal_credentials": []byte{},
+					"amount":                 []byte{},
+					"signature":              []byte{},
+					"index":                  []byte{},
+				}, depositEvent.Inputs)
+				if err != nil {
+					panic(err)
+				}
+				logs = append(logs, &ethgo.Log{
+					BlockNumber:     e.block,
+					TransactionHash: hash,
+					Address:         *txn.To,
+					Topics:          []ethgo.Hash{depositEvent.ID()},
+					Data:            data,
+				})
+			}
+			e.receipts[hash] = map[string]interface{}{
+				"from":              txn.From.String(),
+				"transactionHash":   hash.String(),
+				"blockHash":         ethgo.Hash{0x1}.String(),
+				"transactionIndex":  "0x0",
+				"blockNumber":       fmt.Sprintf("0x%x", e.block),
+				"gasUsed":           "0x0",
+				"cumulativeGasUsed": "0x0",
+				"logsBloom":         "0x" + strings.Repeat("00", 256),
+				"status":            status,
+				"logs":              logs,
+			}
+		}
+		if !found {
+			return
+		}
+	}
+}
+
+func newTestDepositHandler(t *testing.T, eth1 *testEth1) (*depositHandler, *[]*proto.Event) {
+	depositWorkers, resubmitBlocks, blockPollInterval = 4, 2, time.Millisecond
+	t.Cleanup(func() {
+		depositWorkers, resubmitBlocks, blockPollInterval = 32, 5, 500*time.Millisecond
+	})
+
+	client, err := jsonrpc.NewClient(eth1.URL)
+	require.NoError(t, err)
+
+	key, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	events := []*proto.Event{}
+	var lock sync.Mutex
+
+	handler := &depositHandler{
+		deposit: ethgo.Address{0x42},
+		client:  client,
+		key:     key,
+		nonce:   -1,
+		publish: func(event *proto.Event) {
+			lock.Lock()
+			defer lock.Unlock()
+			events = append(events, event)
+		},
+	}
+	return handler, &events
+}
+
+func lastDepositProgress(events []*proto.Event) *proto.Event_DepositProgress {
+	var progress *proto.Event_DepositProgress
+	for _, event := range events {
+		if obj, ok := event.Event.(*proto.Event_DepositProgress_); ok {
+			progress = obj.DepositProgress
+		}
+	}
+	return progress
+}
+
+func TestDepositHandler_MakeDeposits(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, events := newTestDepositHandler(t, eth1)
+
+	accounts := proto.NewAccounts(10)
+	deposits, err := handler.MakeDeposits(context.Background(), accounts, defaultDepositOpts())
+	require.NoError(t, err)
+
+	// the deposits are in the order of the accounts
+	require.Len(t, deposits, 10)
+	for i, acct := range accounts {
+		pub := acct.Bls.PubKey()
+		assert.Equal(t, hex.EncodeToString(pub[:]), deposits[i].PubKey)
+	}
+
+	// a funding and a deposit transaction for each account
+	assert.Equal(t, 20, eth1.sends)
+	assert.Equal(t, int64(9), handler.nonce)
+
+	progress := lastDepositProgress(*events)
+	require.NotNil(t, progress)
+	assert.Equal(t, uint64(10), progress.NumAccounts)
+	assert.Equal(t, uint64(10), progress.Funded)
+	assert.Equal(t, uint64(10), progress.Sent)
+	assert.Equal(t, uint64(10), progress.Mined)
+	assert.Equal(t, uint64(0), progress.Resubmitted)
+}
+
+func TestDepositHandler_NonceRecovery(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, _ := newTestDepositHandler(t, eth1)
+
+	// the transaction is sent again with the nonce of the node
+	eth1.sendErrs = []string{"nonce too low"}
+	handler.nonce = 5
+
+	_, err := handler.MakeDeposits(context.Background(), proto.NewAccounts(2), defaultDepositOpts())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), handler.nonce)
+
+	// the nonce is not used if the node fails to send the transaction
+	eth1.sendErrs = []string{"internal error"}
+
+	_, err = handler.MakeDeposits(context.Background(), proto.NewAccounts(1), defaultDepositOpts())
+	require.Error(t, err)
+	assert.Equal(t, int64(1), handler.nonce)
+
+	// there is no gap in the nonces of the next deposits
+	_, err = handler.MakeDeposits(context.Background(), proto.NewAccounts(2), defaultDepositOpts())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), handler.nonce)
+}
+
+func TestDepositHandler_Resubmit(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, events := newTestDepositHandler(t, eth1)
+
+	// the node drops the first time it receives every deposit
+	dropped := map[ethgo.Address]bool{}
+	eth1.drop = func(txn *ethgo.Transaction, from ethgo.Address) bool {
+		if len(txn.Input) == 0 || dropped[from] {
+			return false
+		}
+		dropped[from] = true
+		return true
+	}
+
+	_, err := handler.MakeDeposits(context.Background(), proto.NewAccounts(3), defaultDepositOpts())
+	require.NoError(t, err)
+
+	progress := lastDepositProgress(*events)
+	require.NotNil(t, progress)
+	assert.Equal(t, uint64(3), progress.Mined)
+	assert.Equal(t, uint64(3), progress.Resubmitted)
+}
+
+func TestDepositHandler_FullBlocks(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, events := newTestDepositHandler(t, eth1)
+
+	// the timeout starts once the previous transactions are mined
+	receiptTimeoutBlocks = 1
+	t.Cleanup(func() {
+		receiptTimeoutBlocks = 120
+	})
+
+	// the deposits take three blocks
+	perBlock := int(eth1.gasLimit / depositGasLimit)
+	numAccounts := 2*perBlock + 1
+
+	deposits, err := handler.MakeDeposits(context.Background(), proto.NewAccounts(numAccounts), defaultDepositOpts())
+	require.NoError(t, err)
+	require.Len(t, deposits, numAccounts)
+
+	blocks := map[string]int{}
+	for _, deposit := range deposits {
+		blocks[eth1.receipts[ethgo.HexToHash(deposit.TxnHash)]["blockNumber"].(string)]++
+	}
+	assert.Len(t, blocks, 3)
+
+	// the receipts are only queried once the transactions are mined
+	assert.Equal(t, 2*numAccounts, eth1.receiptCalls)
+
+	progress := lastDepositProgress(*events)
+	require.NotNil(t, progress)
+	assert.Equal(t, uint64(numAccounts), progress.Mined)
+	assert.Equal(t, uint64(0), progress.Resubmitted)
+}
+
+func TestDepositHandler_InvalidAmount(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, _ := newTestDepositHandler(t, eth1)
+
+	opts := defaultDepositOpts()
+	opts.invalid = proto.InvalidDeposit_InvalidAmount
+
+	deposits, err := handler.MakeDeposit(context.Background(), proto.NewAccount(), opts)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	assert.True(t, deposits[0].Rejected)
+	assert.Equal(t, uint64(minDepositAmount-1), deposits[0].Amount)
+
+	// the deposit contract reverted the deposit
+	receipt := eth1.receipts[ethgo.HexToHash(deposits[0].TxnHash)]
+	assert.Equal(t, "0x0", receipt["status"])
+
+	// a deposit of the minimum amount is not reverted
+	opts = defaultDepositOpts()
+	opts.amount = minDepositAmount
+
+	deposits, err = handler.MakeDeposit(context.Background(), proto.NewAccount(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "0x1", eth1.receipts[ethgo.HexToHash(deposits[0].TxnHash)]["status"])
+}
+
+func TestDepositHandler_NoNewBlocks(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, _ := newTestDepositHandler(t, eth1)
+
+	eth1.halted = true
+
+	t.Run("Timeout", func(t *testing.T) {
+		newBlockTimeout = 50 * time.Millisecond
+		defer func() {
+			newBlockTimeout = 2 * time.Minute
+		}()
+
+		_, err := handler.MakeDeposits(context.Background(), proto.NewAccounts(1), defaultDepositOpts())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no new blocks")
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := handler.MakeDeposits(ctx, proto.NewAccounts(1), defaultDepositOpts())
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestDepositHandler_PartialDeposits(t *testing.T) {
+	eth1 := newTestEth1(t)
+	handler, _ := newTestDepositHandler(t, eth1)
+
+	receiptTimeoutBlocks = 3
+	defer func() {
+		receiptTimeoutBlocks = 120
+	}()
+
+	// the node never mines the deposits
+	eth1.drop = func(txn *ethgo.Transaction, from ethgo.Address) bool {
+		return len(txn.Input) != 0
+	}
+
+	// the deposits sent are returned with the error
+	deposits, err := handler.MakeDeposits(context.Background(), proto.NewAccounts(2), defaultDepositOpts())
+	require.Error(t, err)
+	require.Len(t, deposits, 2)
+	for _, deposit := range deposits {
+		assert.NotEmpty(t, deposit.TxnHash)
+	}
 }
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// subscriptionBuffer is the number of events that can be buffered
+// for a subscriber before it is considered too slow and dropped
+const subscriptionBuffer = 256
+
+// eventBroker delivers the events of the server to the subscribers
+type eventBroker struct {
+	lock   sync.Mutex
+	index  uint64
+	nextID uint64
+	subs   map[uint64]chan *proto.Event
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		subs: map[uint64]chan *proto.Event{},
+	}
+}
+
+// publish sends the event to all the subscribers
+func (b *eventBroker) publish(event *proto.Event) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.index++
+	event.Index = b.index
+	event.Time = time.Now().UnixMilli()
+
+	for id, ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+			// the subscriber is too slow, close the subscription
+			close(ch)
+			delete(b.subs, id)
+		}
+	}
+}
+
+// subscribe returns a channel with the events published from now on and
+// a function to cancel the subscription. The channel is closed if the
+// subscriber does not keep up with the events.
+func (b *eventBroker) subscribe() (<-chan *proto.Event, func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	ch := make(chan *proto.Event, subscriptionBuffer)
+	b.subs[id] = ch
+
+	cancel := func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		if _, ok := b.subs[id]; ok {
+			close(ch)
+			delete(b.subs, id)
+		}
+	}
+	return ch, cancel
+}
+
+func (s *Server) Subscribe(req *proto.SubscribeRequest, stream proto.E2EService_SubscribeServer) error {
+	ch, cancel := s.events.subscribe()
+	defer cancel()
+
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("subscription closed, the client does not keep up with the events")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *Server) publish(event *proto.Event) {
+	s.events.publish(event)
+}
+
+func (s *Server) publishNodeDeployed(node spec.Node) {
+	stub, _ := specNodeToNode(node)
+	s.publish(&proto.Event{
+		Event: &proto.Event_NodeDeployed_{
+			NodeDeployed: &proto.Event_NodeDeployed{
+				Node: stub,
+			},
+		},
+	})
+}
+
+func (s *Server) publishNodeReady(node spec.Node) {
+	stub, _ := specNodeToNode(node)
+	s.publish(&proto.Event{
+		Event: &proto.Event_NodeReady_{
+			NodeReady: &proto.Event_NodeReady{
+				Node: stub,
+			},
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/components"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+func TestEventBroker_Subscribe(t *testing.T) {
+	b := newEventBroker()
+
+	ch, cancel := b.subscribe()
+
+	b.publish(&proto.Event{})
+	b.publish(&proto.Event{})
+
+	assert.Equal(t, uint64(1), (<-ch).Index)
+	assert.Equal(t, uint64(2), (<-ch).Index)
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	// publish after the subscription is cancelled does not block
+	b.publish(&proto.Event{})
+}
+
+func TestEventBroker_SlowSubscriber(t *testing.T) {
+	b := newEventBroker()
+
+	ch, cancel := b.subscribe()
+	defer cancel()
+
+	for i := 0; i < subscriptionBuffer+1; i++ {
+		b.publish(&proto.Event{})
+	}
+
+	// the buffered events are delivered and then the channel is closed
+	num := 0
+	for range ch {
+		num++
+	}
+	assert.Equal(t, subscriptionBuffer, num)
+}
+
+func nextEvent(t *testing.T, ch <-chan *proto.Event) *proto.Event {
+	t.Helper()
+
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+	return nil
+}
+
+func TestServer_Events(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	ch, cancel := srv.events.subscribe()
+	defer cancel()
+
+	_, err := srv.createTranche(1)
+	require.NoError(t, err)
+
+	tranche := nextEvent(t, ch).GetTrancheCreated()
+	require.NotNil(t, tranche)
+	assert.Equal(t, uint64(1), tranche.NumValidators)
+
+	runtime.Hook = func(n *fake.Node) {
+		n.WriteLogs("enode://abcd\n")
+	}
+	node, err := srv.deployNode(components.NewBootnodeV4().Spec.WithName("bootnode-v4"))
+	require.NoError(t, err)
+
+	deployed := nextEvent(t, ch).GetNodeDeployed()
+	require.NotNil(t, deployed)
+	assert.Equal(t, "bootnode-v4", deployed.Node.Name)
+
+	ready := nextEvent(t, ch).GetNodeReady()
+	require.NotNil(t, ready)
+	assert.Equal(t, "bootnode-v4", ready.Node.Name)
+
+	// the node crashes
+	node.(*fake.Node).Exit(fmt.Errorf("exit code 1"))
+
+	exited := nextEvent(t, ch).GetNodeExited()
+	require.NotNil(t, exited)
+	assert.Equal(t, "bootnode-v4", exited.Node.Name)
+	assert.Equal(t, "exit code 1", exited.Error)
+}
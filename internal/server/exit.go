@@ -0,0 +1,239 @@
+package server
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// domainVoluntaryExit is the signature domain of the voluntary exits
+var domainVoluntaryExit = consensus.Domain{0x04, 0x00, 0x00, 0x00}
+
+// DepositExit submits the voluntary exits of the validators of a tranche and
+// sends their status every time it changes until all of them are exited
+func (s *Server) DepositExit(req *proto.DepositExitRequest, stream proto.E2EService_DepositExitServer) error {
+	s.lock.Lock()
+	tranche, ok := s.tranches[req.Tranche]
+	if !ok {
+		s.lock.Unlock()
+		return fmt.Errorf("tranche %d does not exists", req.Tranche)
+	}
+	accounts := tranche.Accounts
+	eth2Spec := *s.config.Spec
+
+	beacon, err := s.exitBeaconLocked(req.Beacon)
+	s.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	api := newBeaconAPI(beacon.GetAddr(proto.NodePortHttp))
+
+	keys := map[string]*bls.Key{}
+	pubKeys := []string{}
+	for _, acct := range accounts {
+		pub := acct.Bls.PubKey()
+		pubKey := "0x" + hex.EncodeToString(pub[:])
+
+		keys[pubKey] = acct.Bls
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	validators, err := api.validators(pubKeys)
+	if err != nil {
+		return err
+	}
+	if validators, err = filterExitValidators(validators, req.Indices); err != nil {
+		return fmt.Errorf("tranche %d: %v", req.Tranche, err)
+	}
+
+	genesisValidatorsRoot, err := api.genesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	headSlot, err := api.headSlot()
+	if err != nil {
+		return err
+	}
+	epoch := headSlot / uint64(eth2Spec.SlotsPerEpoch)
+	forkVersion := eth2Spec.forkVersion(epoch)
+
+	exitPubKeys := []string{}
+	for _, val := range validators {
+		exitPubKeys = append(exitPubKeys, val.Validator.Pubkey)
+
+		if val.Status != "active_ongoing" {
+			if isExitStatus(val.Status) {
+				// the exit was already submitted
+				continue
+			}
+			return fmt.Errorf("validator %d is not active (%s)", val.Index, val.Status)
+		}
+		key, ok := keys[val.Validator.Pubkey]
+		if !ok {
+			return fmt.Errorf("key of validator %d not found", val.Index)
+		}
+
+		exit := &consensus.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: val.Index,
+		}
+		signed, err := signVoluntaryExit(key, exit, forkVersion, genesisValidatorsRoot)
+		if err != nil {
+			return err
+		}
+		if err := api.submitVoluntaryExit(signed); err != nil {
+			return fmt.Errorf("failed to submit the exit of validator %d: %v", val.Index, err)
+		}
+		s.logger.Info("voluntary exit submitted", "tranche", req.Tranche, "index", val.Index, "epoch", epoch)
+	}
+
+	// track the validators until all of them are exited
+	interval := time.Duration(eth2Spec.SecondsPerSlot) * time.Second
+
+	var last string
+	for {
+		validators, err := api.validators(exitPubKeys)
+		if err != nil {
+			s.logger.Warn("failed to query the exited validators", "tranche", req.Tranche, "err", err)
+		} else {
+			resp := exitResponse(validators)
+			if current := resp.String(); current != last {
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+				last = current
+			}
+
+			exited := true
+			for _, val := range validators {
+				if !isExited(val.Status) {
+					exited = false
+				}
+			}
+			if exited {
+				return nil
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// exitBeaconLocked returns the beacon node used to submit the exits
+func (s *Server) exitBeaconLocked(name string) (spec.Node, error) {
+	if name != "" {
+		beacon, err := s.findNodeLocked(name)
+		if err != nil {
+			return nil, err
+		}
+		if !beacon.Spec().HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()) {
+			return nil, fmt.Errorf("node '%s' is not a beacon node", name)
+		}
+		return beacon, nil
+	}
+
+	beacons := s.filterLocked(func(spec *spec.Spec) bool {
+		return spec.HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String())
+	})
+	if len(beacons) == 0 {
+		return nil, fmt.Errorf("no beacon node found")
+	}
+	return beacons[0], nil
+}
+
+// filterExitValidators returns the validators with the given indices. All the
+// validators are returned if there are no indices.
+func filterExitValidators(validators []*beaconValidator, indices []uint64) ([]*beaconValidator, error) {
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("validators not found in the beacon state")
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Index < validators[j].Index
+	})
+	if len(indices) == 0 {
+		return validators, nil
+	}
+
+	res := []*beaconValidator{}
+	for _, index := range indices {
+		var found *beaconValidator
+		for _, val := range validators {
+			if val.Index == index {
+				found = val
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("validator %d not found", index)
+		}
+		res = append(res, found)
+	}
+	return res, nil
+}
+
+// isExitStatus returns true if the validator already initiated its exit
+func isExitStatus(status string) bool {
+	return status == "active_exiting" || status == "active_slashed" || isExited(status)
+}
+
+// isExited returns true if the validator is not part of the active set anymore
+func isExited(status string) bool {
+	return strings.HasPrefix(status, "exited_") || strings.HasPrefix(status, "withdrawal_")
+}
+
+func exitResponse(validators []*beaconValidator) *proto.DepositExitResponse {
+	resp := &proto.DepositExitResponse{}
+	for _, val := range validators {
+		resp.Validators = append(resp.Validators, &proto.DepositExitResponse_Validator{
+			Index:     val.Index,
+			PubKey:    val.Validator.Pubkey,
+			Status:    val.Status,
+			ExitEpoch: val.Validator.ExitEpoch,
+		})
+	}
+	sort.Slice(resp.Validators, func(i, j int) bool {
+		return resp.Validators[i].Index < resp.Validators[j].Index
+	})
+	return resp
+}
+
+func signVoluntaryExit(key *bls.Key, exit *consensus.VoluntaryExit, forkVersion [4]byte, genesisValidatorsRoot consensus.Root) (*consensus.SignedVoluntaryExit, error) {
+	domain, err := consensus.ComputeDomain(domainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	exitRoot, err := exit.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	signingData := &consensus.SigningData{
+		ObjectRoot: exitRoot,
+		Domain:     domain,
+	}
+	rootToSign, err := signingData.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.Sign(rootToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := &consensus.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: signature,
+	}
+	return signed, nil
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"google.golang.org/grpc"
+)
+
+// testExitBeacon is a beacon API with the validators of the head state
+// that are exited once their voluntary exit is submitted
+type testExitBeacon struct {
+	*httptest.Server
+
+	lock    sync.Mutex
+	indices map[string]uint64
+	exits   []*beaconVoluntaryExit
+}
+
+func newTestExitBeacon(t *testing.T) *testExitBeacon {
+	b := &testExitBeacon{
+		indices: map[string]uint64{},
+	}
+	b.Server = httptest.NewServer(http.HandlerFunc(b.handle))
+	t.Cleanup(b.Close)
+	return b
+}
+
+func (b *testExitBeacon) handle(w http.ResponseWriter, r *http.Request) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	switch r.URL.Path {
+	case "/eth/v1/beacon/genesis":
+		fmt.Fprintf(w, `{"data":{"genesis_validators_root":"0x%064x"}}`, 1)
+
+	case "/eth/v1/node/syncing":
+		w.Write([]byte(`{"data":{"head_slot":"64","sync_distance":"0","is_syncing":false}}`))
+
+	case "/eth/v1/beacon/states/head/validators":
+		validators := []string{}
+		for _, pubKey := range strings.Split(r.URL.Query().Get("id"), ",") {
+			index, ok := b.indices[pubKey]
+			if !ok {
+				continue
+			}
+			status, exitEpoch := "active_ongoing", "18446744073709551615"
+			for _, exit := range b.exits {
+				if exit.Message.ValidatorIndex == index {
+					status, exitEpoch = "exited_unslashed", "7"
+				}
+			}
+			validators = append(validators, fmt.Sprintf(`{"index":"%d","status":"%s","validator":{"pubkey":"%s","exit_epoch":"%s"}}`, index, status, pubKey, exitEpoch))
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(validators, ","))
+
+	case "/eth/v1/beacon/pool/voluntary_exits":
+		var exit beaconVoluntaryExit
+		if err := json.NewDecoder(r.Body).Decode(&exit); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		b.exits = append(b.exits, &exit)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// testExitStream collects the responses of the DepositExit endpoint
+type testExitStream struct {
+	grpc.ServerStream
+	resps []*proto.DepositExitResponse
+}
+
+func (s *testExitStream) Send(resp *proto.DepositExitResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func (s *testExitStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestServer_DepositExit(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	srv.config.Spec.SecondsPerSlot = 1
+
+	beacon := newTestExitBeacon(t)
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortHttp, beacon.URL)
+	}
+
+	tranche, err := srv.createTranche(3)
+	require.NoError(t, err)
+
+	for indx, acct := range tranche.Accounts {
+		pub := acct.Bls.PubKey()
+		beacon.indices["0x"+hex.EncodeToString(pub[:])] = uint64(indx + 10)
+	}
+
+	_, err = srv.NodeDeploy(context.Background(), &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 1,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// unknown tranche
+	require.Error(t, srv.DepositExit(&proto.DepositExitRequest{Tranche: 1}, &testExitStream{}))
+
+	// unknown validator index
+	require.Error(t, srv.DepositExit(&proto.DepositExitRequest{Indices: []uint64{1}}, &testExitStream{}))
+
+	stream := &testExitStream{}
+	require.NoError(t, srv.DepositExit(&proto.DepositExitRequest{Indices: []uint64{12, 10}}, stream))
+
+	// only the validators with the indices are exited
+	require.Len(t, beacon.exits, 2)
+	assert.Equal(t, uint64(12), beacon.exits[0].Message.ValidatorIndex)
+	assert.Equal(t, uint64(10), beacon.exits[1].Message.ValidatorIndex)
+	assert.Equal(t, 64/uint64(srv.config.Spec.SlotsPerEpoch), beacon.exits[0].Message.Epoch)
+
+	require.NotEmpty(t, stream.resps)
+	last := stream.resps[len(stream.resps)-1]
+	require.Len(t, last.Validators, 2)
+	for _, val := range last.Validators {
+		assert.Equal(t, "exited_unslashed", val.Status)
+		assert.Equal(t, uint64(7), val.ExitEpoch)
+	}
+
+	// the exits are not submitted again
+	require.NoError(t, srv.DepositExit(&proto.DepositExitRequest{Indices: []uint64{10}}, &testExitStream{}))
+	require.Len(t, beacon.exits, 2)
+}
+
+func TestSignVoluntaryExit(t *testing.T) {
+	key := bls.NewRandomKey()
+	exit := &consensus.VoluntaryExit{
+		Epoch:          5,
+		ValidatorIndex: 3,
+	}
+	forkVersion := [4]byte{0x80, 0x0, 0x0, 0x70}
+	genesisValidatorsRoot := consensus.Root{0x1}
+
+	signed, err := signVoluntaryExit(key, exit, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, exit, signed.Exit)
+
+	domain, err := consensus.ComputeDomain(domainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := (&consensus.SigningData{ObjectRoot: exitRoot, Domain: domain}).HashTreeRoot()
+	require.NoError(t, err)
+
+	pub := new(bls.PublicKey)
+	pubKey := key.PubKey()
+	require.NoError(t, pub.Deserialize(pubKey[:]))
+
+	sig := new(bls.Signature)
+	require.NoError(t, sig.Deserialize(signed.Signature[:]))
+
+	ok, err := sig.VerifyByte(pub, root[:])
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/components"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+// depositCliVersion is the version of the staking deposit cli
+// reported in the deposit data. The launchpad rejects old versions.
+const depositCliVersion = "2.3.0"
+
+func (s *Server) DepositExport(ctx context.Context, req *proto.DepositExportRequest) (*proto.DepositExportResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tranche, ok := s.tranches[req.Tranche]
+	if !ok {
+		return nil, fmt.Errorf("tranche %d does not exists", req.Tranche)
+	}
+
+	password := req.Password
+	if password == "" {
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		password = hex.EncodeToString(buf)
+	}
+
+	var files []*proto.DepositExportResponse_File
+	var err error
+
+	switch req.Format {
+	case proto.ExportFormat_Keystores:
+		files, err = exportKeystores(tranche.Accounts, password)
+	case proto.ExportFormat_DepositData:
+		files, err = s.exportDepositData(tranche)
+	case proto.ExportFormat_PrysmWallet:
+		files, err = exportPrysmWallet(tranche.Accounts, password)
+	case proto.ExportFormat_Raw:
+		files, err = exportRaw(tranche.Accounts)
+	default:
+		err = fmt.Errorf("export format %s not found", req.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.DepositExportResponse{
+		Files: files,
+	}
+	return resp, nil
+}
+
+// keystoreName is the name of the keystore of the account. The derived
+// accounts use the derivation path as the staking deposit cli.
+func keystoreName(acct *proto.Account) string {
+	if acct.Index != nil {
+		return fmt.Sprintf("keystore-m_12381_3600_%d_0_0", *acct.Index)
+	}
+	pub := acct.Bls.PubKey()
+	return "keystore-0x" + hex.EncodeToString(pub[:])
+}
+
+// exportKeystores exports the accounts as EIP-2335 keystores. The keystores
+// are in the 'keys' folder and their passwords in the 'passwords' folder
+// with the same name.
+func exportKeystores(accounts []*proto.Account, password string) ([]*proto.DepositExportResponse_File, error) {
+	files := []*proto.DepositExportResponse_File{}
+	for _, acct := range accounts {
+		keystore, err := toEIP2335Keystore(acct, password)
+		if err != nil {
+			return nil, err
+		}
+		name := keystoreName(acct)
+		files = append(files,
+			&proto.DepositExportResponse_File{Path: "keys/" + name + ".json", Content: keystore},
+			&proto.DepositExportResponse_File{Path: "passwords/" + name + ".txt", Content: []byte(password)},
+		)
+	}
+	return files, nil
+}
+
+// toEIP2335Keystore encodes the key of the account as an EIP-2335 keystore
+// with the derivation path of the account
+func toEIP2335Keystore(acct *proto.Account, password string) ([]byte, error) {
+	data, err := bls.ToKeystore(acct.Bls, password)
+	if err != nil {
+		return nil, err
+	}
+	var keystore map[string]interface{}
+	if err := json.Unmarshal(data, &keystore); err != nil {
+		return nil, err
+	}
+
+	keystore["path"] = ""
+	if acct.Index != nil {
+		keystore["path"] = fmt.Sprintf("m/12381/3600/%d/0/0", *acct.Index)
+	}
+	keystore["description"] = "viewpoint"
+	return json.MarshalIndent(keystore, "", "\t")
+}
+
+// depositDataEntry is an entry of the deposit_data.json file of the launchpad
+type depositDataEntry struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+	DepositCliVersion     string `json:"deposit_cli_version"`
+}
+
+// exportDepositData exports the signed deposits of the accounts in the
+// deposit_data.json format of the staking launchpad. The entries match the
+// valid deposits sent for the accounts, the accounts without deposits
+// (i.e. genesis validators) use the default deposit.
+func (s *Server) exportDepositData(tranche *Tranche) ([]*proto.DepositExportResponse_File, error) {
+	forkVersion := s.config.Spec.GenesisForkVersion
+
+	type depositEntry struct {
+		amount      uint64
+		credentials [32]byte
+	}
+	sent := map[string][]*depositEntry{}
+	for _, dep := range tranche.Deposits {
+		if dep.Invalid != proto.InvalidDeposit_NoInvalidDeposit {
+			// the invalid deposits are not signed by the key of the account
+			continue
+		}
+		buf, err := hex.DecodeString(dep.WithdrawalCredentials)
+		if err != nil {
+			return nil, fmt.Errorf("invalid withdrawal credentials of deposit %s: %v", dep.TxnHash, err)
+		}
+		if len(buf) != 32 {
+			return nil, fmt.Errorf("withdrawal credentials of deposit %s of %d bytes, expected 32", dep.TxnHash, len(buf))
+		}
+		entry := &depositEntry{amount: dep.Amount}
+		copy(entry.credentials[:], buf)
+		sent[dep.PubKey] = append(sent[dep.PubKey], entry)
+	}
+
+	entries := []*depositDataEntry{}
+	for _, acct := range tranche.Accounts {
+		pub := acct.Bls.PubKey()
+		deposits, ok := sent[hex.EncodeToString(pub[:])]
+		if !ok {
+			opts := defaultDepositOpts()
+			deposits = []*depositEntry{{amount: opts.amount, credentials: opts.withdrawalCredentials(acct.Bls)}}
+		}
+
+		for _, dep := range deposits {
+			data, err := signDeposit(acct.Bls, dep.amount, dep.credentials, forkVersion)
+			if err != nil {
+				return nil, err
+			}
+			msg := &consensus.DepositMessage{
+				Pubkey:                data.Pubkey,
+				WithdrawalCredentials: data.WithdrawalCredentials,
+				Amount:                data.Amount,
+			}
+			msgRoot, err := msg.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, &depositDataEntry{
+				Pubkey:                hex.EncodeToString(data.Pubkey[:]),
+				WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials[:]),
+				Amount:                data.Amount,
+				Signature:             hex.EncodeToString(data.Signature[:]),
+				DepositMessageRoot:    hex.EncodeToString(msgRoot[:]),
+				DepositDataRoot:       hex.EncodeToString(data.Root[:]),
+				ForkVersion:           hex.EncodeToString(forkVersion[:]),
+				NetworkName:           s.config.Name,
+				DepositCliVersion:     depositCliVersion,
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(entries, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	files := []*proto.DepositExportResponse_File{
+		{Path: "deposit_data.json", Content: data},
+	}
+	return files, nil
+}
+
+// exportPrysmWallet exports the accounts as an imported prysm wallet
+// to use with the '--wallet-dir' and '--wallet-password-file' flags
+func exportPrysmWallet(accounts []*proto.Account, password string) ([]*proto.DepositExportResponse_File, error) {
+	keystore, err := components.NewPrysmWalletKeystore(accounts, password)
+	if err != nil {
+		return nil, err
+	}
+	files := []*proto.DepositExportResponse_File{
+		{Path: "direct/accounts/all-accounts.keystore.json", Content: keystore},
+		{Path: "wallet-password.txt", Content: []byte(password)},
+	}
+	return files, nil
+}
+
+// exportRaw exports the hex encoded private keys of the accounts,
+// one for each line as in the tranche file
+func exportRaw(accounts []*proto.Account) ([]*proto.DepositExportResponse_File, error) {
+	privKeys := []string{}
+	for _, acct := range accounts {
+		priv, err := acct.Bls.Prv.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		privKeys = append(privKeys, hex.EncodeToString(priv))
+	}
+	files := []*proto.DepositExportResponse_File{
+		{Path: "keys.txt", Content: []byte(strings.Join(privKeys, "\n"))},
+	}
+	return files, nil
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/keystore"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+func TestServer_DepositExport(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.Mnemonic = "test test test test test test test test test test test junk"
+
+	var err error
+	srv.keySeed, err = srv.config.KeySeed()
+	require.NoError(t, err)
+
+	_, err = srv.createTranche(1)
+	require.NoError(t, err)
+	tranche, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	export := func(format proto.ExportFormat) map[string][]byte {
+		req := &proto.DepositExportRequest{
+			Tranche:  1,
+			Format:   format,
+			Password: "secret",
+		}
+		resp, err := srv.DepositExport(context.Background(), req)
+		require.NoError(t, err)
+
+		files := map[string][]byte{}
+		for _, file := range resp.Files {
+			files[file.Path] = file.Content
+		}
+		return files
+	}
+
+	t.Run("keystores", func(t *testing.T) {
+		files := export(proto.ExportFormat_Keystores)
+		require.Len(t, files, 4)
+
+		// the keystores are named after the derivation path
+		content := files["keys/keystore-m_12381_3600_2_0_0.json"]
+		assert.Equal(t, "secret", string(files["passwords/keystore-m_12381_3600_2_0_0.txt"]))
+
+		key, err := bls.FromKeystore(content, "secret")
+		require.NoError(t, err)
+		assert.True(t, key.Equal(tranche.Accounts[1].Bls))
+
+		var dec map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &dec))
+		assert.Equal(t, "m/12381/3600/2/0/0", dec["path"])
+		assert.Equal(t, float64(4), dec["version"])
+		assert.NotEmpty(t, dec["uuid"])
+	})
+
+	t.Run("deposit data", func(t *testing.T) {
+		files := export(proto.ExportFormat_DepositData)
+
+		var entries []*depositDataEntry
+		require.NoError(t, json.Unmarshal(files["deposit_data.json"], &entries))
+		require.Len(t, entries, 2)
+
+		for i, entry := range entries {
+			credentials := defaultDepositOpts().withdrawalCredentials(tranche.Accounts[i].Bls)
+			data, err := signDeposit(tranche.Accounts[i].Bls, entry.Amount, credentials, srv.config.Spec.GenesisForkVersion)
+			require.NoError(t, err)
+
+			assert.Equal(t, hex.EncodeToString(data.Pubkey[:]), entry.Pubkey)
+			assert.Equal(t, hex.EncodeToString(data.Root[:]), entry.DepositDataRoot)
+			assert.Equal(t, hex.EncodeToString(credentials[:]), entry.WithdrawalCredentials)
+			assert.Equal(t, "00000000", entry.ForkVersion)
+			assert.Equal(t, srv.config.Name, entry.NetworkName)
+			assert.Len(t, entry.DepositMessageRoot, 64)
+		}
+	})
+
+	t.Run("deposit data of the sent deposits", func(t *testing.T) {
+		opts := &depositOpts{
+			amount:            1000000000,
+			credentials:       proto.WithdrawalCredentials_ExecutionCredentials,
+			withdrawalAddress: ethgo.Address{0x1},
+		}
+		credentials := opts.withdrawalCredentials(tranche.Accounts[0].Bls)
+		pub := tranche.Accounts[0].Bls.PubKey()
+
+		tranche.Deposits = []*proto.DepositStub{
+			{
+				PubKey:                hex.EncodeToString(pub[:]),
+				Amount:                opts.amount,
+				WithdrawalCredentials: hex.EncodeToString(credentials[:]),
+			},
+			{
+				// the invalid deposits are not exported
+				PubKey:                hex.EncodeToString(pub[:]),
+				Amount:                1,
+				WithdrawalCredentials: hex.EncodeToString(credentials[:]),
+				Invalid:               proto.InvalidDeposit_InvalidAmount,
+			},
+		}
+		defer func() {
+			tranche.Deposits = nil
+		}()
+
+		files := export(proto.ExportFormat_DepositData)
+
+		var entries []*depositDataEntry
+		require.NoError(t, json.Unmarshal(files["deposit_data.json"], &entries))
+		require.Len(t, entries, 2)
+
+		// the entry of the first account matches its deposit
+		assert.Equal(t, opts.amount, entries[0].Amount)
+		assert.Equal(t, hex.EncodeToString(credentials[:]), entries[0].WithdrawalCredentials)
+
+		data, err := signDeposit(tranche.Accounts[0].Bls, opts.amount, credentials, srv.config.Spec.GenesisForkVersion)
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(data.Root[:]), entries[0].DepositDataRoot)
+
+		// the second account does not have deposits and uses the default one
+		assert.Equal(t, defaultDepositOpts().amount, entries[1].Amount)
+	})
+
+	t.Run("prysm wallet", func(t *testing.T) {
+		files := export(proto.ExportFormat_PrysmWallet)
+		assert.Equal(t, "secret", string(files["wallet-password.txt"]))
+
+		raw, err := keystore.DecryptV4(files["direct/accounts/all-accounts.keystore.json"], "secret")
+		require.NoError(t, err)
+
+		var store struct {
+			PublicKeys [][]byte `json:"public_keys"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &store))
+		require.Len(t, store.PublicKeys, 2)
+
+		pub := tranche.Accounts[0].Bls.PubKey()
+		assert.Equal(t, pub[:], store.PublicKeys[0])
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		files := export(proto.ExportFormat_Raw)
+
+		keys := strings.Split(string(files["keys.txt"]), "\n")
+		require.Len(t, keys, 2)
+
+		priv, err := tranche.Accounts[1].Bls.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(priv), keys[1])
+	})
+
+	_, err = srv.DepositExport(context.Background(), &proto.DepositExportRequest{Tranche: 5})
+	require.Error(t, err)
+}
+
+func TestServer_DepositImport_Invalid(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	tranche, err := srv.createTranche(1)
+	require.NoError(t, err)
+
+	importKeys := func(keys ...*bls.Key) error {
+		req := &proto.DepositImportRequest{}
+		for _, key := range keys {
+			priv, err := key.Marshal()
+			require.NoError(t, err)
+			req.Keys = append(req.Keys, priv)
+		}
+		_, err := srv.DepositImport(context.Background(), req)
+		return err
+	}
+
+	require.Error(t, importKeys())
+
+	// the key is already part of a tranche
+	require.Error(t, importKeys(tranche.Accounts[0].Bls))
+
+	// the keys are duplicated
+	key := bls.NewRandomKey()
+	require.Error(t, importKeys(key, key))
+
+	_, err = srv.DepositImport(context.Background(), &proto.DepositImportRequest{Keys: [][]byte{{0x1}}})
+	require.Error(t, err)
+
+	require.Len(t, srv.tranches, 1)
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// grpcAddrFile is the file in the e2e folder with the address of the grpc server
+	grpcAddrFile = "grpc_addr"
+
+	// grpcCertFile and grpcKeyFile are the files in the e2e folder with
+	// the self-signed TLS certificate of the grpc server
+	grpcCertFile = "grpc.crt"
+	grpcKeyFile  = "grpc.key"
+)
+
+const unixPrefix = "unix://"
+
+// listenGrpc listens on a tcp address or a unix socket (unix:///path) and returns
+// the address that the clients can use to dial the listener
+func listenGrpc(addr string) (net.Listener, string, error) {
+	if strings.HasPrefix(addr, "unix:") {
+		path := strings.TrimPrefix(strings.TrimPrefix(addr, unixPrefix), "unix:")
+
+		// remove the socket of a previous server
+		if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(path); err != nil {
+				return nil, "", err
+			}
+		}
+		lis, err := net.Listen("unix", path)
+		if err != nil {
+			return nil, "", err
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, "", err
+		}
+		return lis, unixPrefix + absPath, nil
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, "", err
+	}
+	return lis, lis.Addr().String(), nil
+}
+
+// grpcTLSConfig returns the tls config of the grpc server. The self-signed
+// certificate is created the first time and stored in the e2e folder.
+func (s *Server) grpcTLSConfig() (*tls.Config, error) {
+	certPath := filepath.Join(s.logDir.path, grpcCertFile)
+	keyPath := filepath.Join(s.logDir.path, grpcKeyFile)
+
+	if _, err := os.Stat(certPath); os.IsNotExist(err) {
+		certPEM, keyPEM, err := generateCert()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate certificate: %v", err)
+		}
+		if _, err := s.logDir.writeFile(grpcCertFile, certPEM); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(keyPath, keyPEM, 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return config, nil
+}
+
+// generateCert generates a self-signed certificate valid for localhost
+func generateCert() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"viewpoint"},
+		},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1"), net.IPv6loopback},
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		template.DNSNames = append(template.DNSNames, hostname)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return certPEM, keyPEM, nil
+}
+
+// tokenAuth is a grpc interceptor that checks the bearer token of the requests
+type tokenAuth struct {
+	token string
+}
+
+func (t *tokenAuth) authorize(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authorization token not found")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "authorization token not found")
+	}
+	token := strings.TrimPrefix(values[0], "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(t.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+	return nil
+}
+
+func (t *tokenAuth) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := t.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (t *tokenAuth) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := t.authorize(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
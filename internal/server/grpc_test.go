@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testGrpcClient(t *testing.T, addr string, config *proto.DialConfig) proto.E2EServiceClient {
+	conn, err := proto.Dial(addr, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	return proto.NewE2EServiceClient(conn)
+}
+
+func TestGrpc_RandomPort(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.GrpcAddr = "127.0.0.1:0"
+
+	require.NoError(t, srv.setupGrpcServer())
+	assert.False(t, strings.HasSuffix(srv.GrpcAddr(), ":0"))
+
+	// the address is written in the e2e folder
+	data, err := ioutil.ReadFile(filepath.Join(srv.logDir.path, grpcAddrFile))
+	require.NoError(t, err)
+	assert.Equal(t, srv.GrpcAddr(), string(data))
+
+	clt := testGrpcClient(t, srv.GrpcAddr(), nil)
+	_, err = clt.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+}
+
+func TestGrpc_UnixSocket(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.GrpcAddr = "unix://" + filepath.Join(t.TempDir(), "viewpoint.sock")
+
+	require.NoError(t, srv.setupGrpcServer())
+	assert.Equal(t, srv.config.GrpcAddr, srv.GrpcAddr())
+
+	clt := testGrpcClient(t, srv.GrpcAddr(), nil)
+	_, err := clt.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+}
+
+func TestGrpc_TLSAndToken(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.GrpcAddr = "127.0.0.1:0"
+	srv.config.GrpcTLS = true
+	srv.config.GrpcToken = "secret"
+
+	require.NoError(t, srv.setupGrpcServer())
+
+	certFile := srv.GrpcCertFile()
+	require.FileExists(t, certFile)
+
+	listNodes := func(config *proto.DialConfig) error {
+		clt := testGrpcClient(t, srv.GrpcAddr(), config)
+		_, err := clt.NodeList(context.Background(), &proto.NodeListRequest{})
+		return err
+	}
+
+	require.NoError(t, listNodes(&proto.DialConfig{CAFile: certFile, Token: "secret"}))
+
+	// wrong or missing token
+	err := listNodes(&proto.DialConfig{CAFile: certFile, Token: "other"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = listNodes(&proto.DialConfig{CAFile: certFile})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the server does not accept connections without TLS
+	require.Error(t, listNodes(&proto.DialConfig{Token: "secret"}))
+}
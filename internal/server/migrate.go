@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/components"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// slashingJobTimeout is the maximum amount of time to wait
+// for a slashing protection job to finish
+var slashingJobTimeout = 2 * time.Minute
+
+var slashingProtectionFactory = map[proto.NodeClient]*proto.SlashingProtection{
+	proto.NodeClient_Teku: {
+		Export: components.NewTekuSlashingExport,
+		Import: components.NewTekuSlashingImport,
+	},
+	proto.NodeClient_Prysm: {
+		Export: components.NewPrysmSlashingExport,
+		Import: components.NewPrysmSlashingImport,
+	},
+	proto.NodeClient_Lighthouse: {
+		Export: components.NewLighthouseSlashingExport,
+		Import: components.NewLighthouseSlashingImport,
+	},
+	proto.NodeClient_Nimbus: {
+		Export: components.NewNimbusSlashingExport,
+		Import: components.NewNimbusSlashingImport,
+	},
+	proto.NodeClient_Lodestar: {
+		Export: components.NewLodestarSlashingExport,
+		Import: components.NewLodestarSlashingImport,
+	},
+}
+
+// ValidatorMigrate moves the tranche of a validator to a new validator of another client.
+// The validator is stopped and its slashing protection database is exported and imported
+// in the new validator before it starts. The validator is removed once the new one is deployed.
+func (s *Server) ValidatorMigrate(ctx context.Context, req *proto.ValidatorMigrateRequest) (*proto.ValidatorMigrateResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.persist()
+
+	node, err := s.findNodeLocked(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !node.Spec().HasLabel(proto.NodeTypeLabel, proto.NodeType_Validator.String()) {
+		return nil, fmt.Errorf("node '%s' is not a validator node", req.Name)
+	}
+	client, _ := proto.StringToNodeClient(node.Spec().Labels[proto.NodeClientLabel])
+	if client == req.NodeClient {
+		return nil, fmt.Errorf("validator '%s' already uses the client %s", req.Name, client)
+	}
+
+	var index uint64
+	var tranche *Tranche
+	for i, t := range s.tranches {
+		if t.Validator == req.Name {
+			index, tranche = i, t
+		}
+	}
+	if tranche == nil {
+		return nil, fmt.Errorf("validator '%s' does not have a tranche", req.Name)
+	}
+
+	// the beacon node is only used by the clients that query
+	// the genesis validators root to export the database
+	beacons := s.filterLocked(func(spec *spec.Spec) bool {
+		return spec.HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String())
+	})
+	if len(beacons) == 0 {
+		return nil, fmt.Errorf("no beacon node found")
+	}
+
+	s.logger.Info("migrate validator", "name", req.Name, "client", req.NodeClient)
+
+	// the validator must not sign anymore before its database is exported
+	s.status[req.Name].stop()
+	if err := node.Stop(); err != nil {
+		return nil, err
+	}
+
+	vCfg := &proto.ValidatorConfig{
+		Accounts:   tranche.Accounts,
+		Spec:       s.config.Spec.buildConfig(),
+		Beacon:     beacons[0],
+		Preset:     s.config.Spec.Preset,
+		GenesisSSZ: s.genesisSSZ,
+	}
+	interchange, err := s.exportSlashingProtection(client, vCfg, node)
+	if err != nil {
+		return nil, err
+	}
+
+	// deploy the new validator with the tranche of the stopped one
+	tranche.Validator = ""
+
+	deployReq := &proto.NodeDeployRequest{
+		NodeClient:    req.NodeClient,
+		Repo:          req.Repo,
+		Tag:           req.Tag,
+		RestartPolicy: req.RestartPolicy,
+		MaxRetries:    req.MaxRetries,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumTranch:          index,
+				Beacon:             req.Beacon,
+				SlashingProtection: interchange,
+			},
+		},
+	}
+	deployResp, err := s.nodeDeployLocked(deployReq)
+	if err != nil {
+		// the tranche is still owned by the stopped validator
+		tranche.Validator = req.Name
+		return nil, err
+	}
+	newNode := deployResp.Nodes[len(deployResp.Nodes)-1]
+
+	// remove the previous validator (and its signer) so that it cannot
+	// be started again and sign with the same keys as the new one
+	if err := s.removeNodePairLocked(node); err != nil {
+		return nil, fmt.Errorf("validator migrated to '%s' but failed to remove '%s': %v", newNode.Name, req.Name, err)
+	}
+
+	resp := &proto.ValidatorMigrateResponse{
+		Node:               newNode,
+		SlashingProtection: interchange,
+	}
+	return resp, nil
+}
+
+// exportSlashingProtection exports the slashing protection database of a
+// stopped validator node as an EIP-3076 interchange file
+func (s *Server) exportSlashingProtection(client proto.NodeClient, config *proto.ValidatorConfig, node spec.Node) ([]byte, error) {
+	jobs, ok := slashingProtectionFactory[client]
+	if !ok {
+		return nil, fmt.Errorf("slashing protection of client %s not found", client)
+	}
+	job, err := jobs.Export(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// the job runs over a copy of the data of the validator
+	info, err := node.Info()
+	if err != nil {
+		return nil, err
+	}
+	data, err := readMountFiles(info.Mounts, "/data")
+	if err != nil {
+		return nil, err
+	}
+	withMissingFiles(job, data)
+
+	job.WithName(node.Spec().Name + "-export").
+		WithContainer(node.Spec().Repository).
+		WithTag(node.Spec().Tag)
+
+	files, err := s.runJob(job)
+	if err != nil {
+		return nil, err
+	}
+	interchange, ok := files[components.SlashingProtectionFile]
+	if !ok {
+		return nil, fmt.Errorf("slashing protection file not found in the export of '%s'", node.Spec().Name)
+	}
+	return interchange, nil
+}
+
+// importSlashingProtection imports the EIP-3076 interchange file in the slashing
+// protection database of the validator spec before it is deployed
+func (s *Server) importSlashingProtection(name string, client proto.NodeClient, config *proto.ValidatorConfig, validator *spec.Spec, interchange []byte) error {
+	jobs, ok := slashingProtectionFactory[client]
+	if !ok {
+		return fmt.Errorf("slashing protection of client %s not found", client)
+	}
+	job, err := jobs.Import(config)
+	if err != nil {
+		return err
+	}
+	job.WithName(name+"-import").
+		WithContainer(validator.Repository).
+		WithTag(validator.Tag).
+		WithFile(components.SlashingProtectionFile, interchange)
+
+	// the database created by the job is part of the data of the validator
+	files, err := s.runJob(job)
+	if err != nil {
+		return err
+	}
+	withMissingFiles(validator, files)
+	return nil
+}
+
+// runJob deploys a node that runs until it exits and returns the
+// files of its data. The node is not tracked by the server.
+func (s *Server) runJob(job *spec.Spec) (map[string][]byte, error) {
+	s.logger.Info("run job", "name", job.Name)
+
+	node, err := s.runtime.Deploy(job)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := node.Remove(); err != nil {
+			s.logger.Error("failed to remove job", "name", job.Name, "err", err)
+		}
+	}()
+
+	select {
+	case <-node.WaitCh():
+	case <-time.After(slashingJobTimeout):
+		return nil, fmt.Errorf("job '%s' did not finish after %s", job.Name, slashingJobTimeout)
+	}
+	if res := node.ExitResult(); res != nil && res.Failed() {
+		path, err := s.writeExitLogs(node)
+		if err != nil {
+			s.logger.Error("failed to write exit logs", "name", job.Name, "err", err)
+		}
+		return nil, fmt.Errorf("job '%s' failed with exit code %d (logs in %s)", job.Name, res.ExitCode, path)
+	}
+
+	info, err := node.Info()
+	if err != nil {
+		return nil, err
+	}
+	return readMountFiles(info.Mounts, "/data")
+}
+
+// readMountFiles reads the files of a mount of a node from the host. The files
+// are indexed by their path in the node.
+func readMountFiles(mounts map[string]string, mount string) (map[string][]byte, error) {
+	local, ok := mounts[mount]
+	if !ok {
+		return nil, fmt.Errorf("mount '%s' not found", mount)
+	}
+
+	files := map[string][]byte{}
+	err := filepath.Walk(local, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(local, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.Join(mount, rel)] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// withMissingFiles adds the files to the spec unless the spec already sets them
+func withMissingFiles(s *spec.Spec, files map[string][]byte) {
+	for path, content := range files {
+		if _, ok := s.Files[path]; !ok {
+			s.WithFile(path, content)
+		}
+	}
+}
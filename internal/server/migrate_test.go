@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/components"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+)
+
+func TestServer_ValidatorMigrate(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	runtime.DataDir = t.TempDir()
+
+	interchange := []byte(`{"metadata":{"interchange_format_version":"5"},"data":[]}`)
+
+	runtime.Hook = func(n *fake.Node) {
+		name := n.Spec().Name
+		if strings.HasSuffix(name, "-export") {
+			require.NoError(t, n.WriteFile(components.SlashingProtectionFile, interchange))
+			n.Exit(nil)
+		}
+		if strings.HasSuffix(name, "-import") {
+			require.NoError(t, n.WriteFile("/data/node/validators/slashing_protection.sqlite", []byte("db")))
+			n.Exit(nil)
+		}
+	}
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				WithBeacon:  true,
+				BeaconCount: 1,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	beacon, validator := resp.Nodes[0].Name, resp.Nodes[1].Name
+
+	migrate := func(client proto.NodeClient) (*proto.ValidatorMigrateResponse, error) {
+		req := &proto.ValidatorMigrateRequest{
+			Name:       validator,
+			NodeClient: client,
+			Beacon:     beacon,
+		}
+		return srv.ValidatorMigrate(context.Background(), req)
+	}
+
+	_, err = migrate(proto.NodeClient_Teku)
+	require.Error(t, err)
+
+	migrateResp, err := migrate(proto.NodeClient_Lighthouse)
+	require.NoError(t, err)
+	assert.Equal(t, interchange, migrateResp.SlashingProtection)
+	assert.Equal(t, proto.NodeClient_Lighthouse, migrateResp.Node.Client)
+
+	nodes := runtime.Nodes()
+	require.Len(t, nodes, 5)
+
+	// the previous validator is removed
+	assert.True(t, nodes[1].IsRemoved())
+	_, err = srv.findNodeLocked(validator)
+	require.Error(t, err)
+
+	// the export job runs over the data of the validator
+	export := nodes[2]
+	assert.Equal(t, validator+"-export", export.Spec().Name)
+	assert.Equal(t, "consensys/teku", export.Spec().Repository)
+	assert.Contains(t, export.Spec().Files, "/data/keys/account_0.json")
+	assert.True(t, export.IsRemoved())
+
+	// the new validator includes the database with the interchange
+	assert.True(t, nodes[3].IsRemoved())
+	newValidator := nodes[4].Spec()
+	assert.Equal(t, "db", string(newValidator.Files["/data/node/validators/slashing_protection.sqlite"]))
+	assert.Equal(t, interchange, newValidator.Files[components.SlashingProtectionFile])
+
+	// the tranche is used by the new validator
+	assert.Equal(t, migrateResp.Node.Name, srv.tranches[0].Validator)
+}
+
+func TestServer_ValidatorMigrate_FailedExport(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	runtime.DataDir = t.TempDir()
+
+	runtime.Hook = func(n *fake.Node) {
+		if strings.HasSuffix(n.Spec().Name, "-export") {
+			n.ExitWithCode(1, nil)
+		}
+	}
+
+	_, err := srv.createTranche(1)
+	require.NoError(t, err)
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Prysm,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				WithBeacon:  true,
+				BeaconCount: 1,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	validator := resp.Nodes[1].Name
+
+	_, err = srv.ValidatorMigrate(context.Background(), &proto.ValidatorMigrateRequest{
+		Name:       validator,
+		NodeClient: proto.NodeClient_Teku,
+	})
+	require.Error(t, err)
+
+	// the tranche is still owned by the validator
+	assert.Equal(t, validator, srv.tranches[0].Validator)
+}
+
+func TestServer_ValidatorMigrate_RemoteSigner(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	runtime.DataDir = t.TempDir()
+
+	web3signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer web3signer.Close()
+
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortWeb3Signer, web3signer.URL)
+
+		name := n.Spec().Name
+		if strings.HasSuffix(name, "-export") {
+			require.NoError(t, n.WriteFile(components.SlashingProtectionFile, []byte("{}")))
+			n.Exit(nil)
+		}
+		if strings.HasSuffix(name, "-import") {
+			n.Exit(nil)
+		}
+	}
+
+	_, err := srv.createTranche(1)
+	require.NoError(t, err)
+
+	resp, err := srv.NodeDeploy(context.Background(), &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				WithBeacon:   true,
+				BeaconCount:  1,
+				RemoteSigner: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	beacon, signer, validator := resp.Nodes[0].Name, resp.Nodes[1].Name, resp.Nodes[2].Name
+
+	_, err = srv.ValidatorMigrate(context.Background(), &proto.ValidatorMigrateRequest{
+		Name:       validator,
+		NodeClient: proto.NodeClient_Lighthouse,
+		Beacon:     beacon,
+	})
+	require.NoError(t, err)
+
+	// the signer of the previous validator is removed with it
+	nodes := runtime.Nodes()
+	assert.True(t, nodes[1].IsRemoved())
+	assert.True(t, nodes[2].IsRemoved())
+
+	_, err = srv.findNodeLocked(signer)
+	require.Error(t, err)
+	_, err = srv.findNodeLocked(validator)
+	require.Error(t, err)
+	assert.Len(t, srv.nodes, 2)
+}
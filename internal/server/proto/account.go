@@ -10,6 +10,10 @@ import (
 type Account struct {
 	Bls   *bls.Key
 	Ecdsa *wallet.Key
+
+	// Index is the derivation index of the account if it
+	// was derived from a seed (see NewDerivedAccount)
+	Index *uint64
 }
 
 func NewAccounts(num int) []*Account {
@@ -21,12 +25,18 @@ func NewAccounts(num int) []*Account {
 }
 
 func NewAccount() *Account {
+	return NewAccountFromKey(bls.NewRandomKey())
+}
+
+// NewAccountFromKey creates an account for an existing validator
+// key with a new ECDSA key to send its deposit
+func NewAccountFromKey(blsKey *bls.Key) *Account {
 	key, err := wallet.GenerateKey()
 	if err != nil {
 		panic(fmt.Errorf("BUG: failed to generate key %v", err))
 	}
 	account := &Account{
-		Bls:   bls.NewRandomKey(),
+		Bls:   blsKey,
 		Ecdsa: key,
 	}
 	return account
@@ -0,0 +1,61 @@
+package proto
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DialConfig is the config to connect with the grpc server
+type DialConfig struct {
+	// CAFile is the certificate of the server. If set, the connection uses TLS.
+	CAFile string
+
+	// Token is the bearer token sent with every request
+	Token string
+}
+
+// Dial connects with the grpc server in addr. The address can be
+// a unix socket (unix:///path).
+func Dial(addr string, config *DialConfig) (*grpc.ClientConn, error) {
+	if config == nil {
+		config = &DialConfig{}
+	}
+
+	opts := []grpc.DialOption{}
+	if config.CAFile != "" {
+		creds, err := credentials.NewClientTLSFromFile(config.CAFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load certificate: %v", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{
+			token:  config.Token,
+			secure: config.CAFile != "",
+		}))
+	}
+	return grpc.Dial(addr, opts...)
+}
+
+// tokenCredentials sends the bearer token with every request
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + t.token,
+	}, nil
+}
+
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
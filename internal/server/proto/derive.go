@@ -0,0 +1,151 @@
+package proto
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/umbracle/ethgo/wallet"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"golang.org/x/crypto/hkdf"
+)
+
+// MnemonicToSeed returns the BIP-39 seed of a mnemonic without passphrase
+func MnemonicToSeed(mnemonic string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return seed, nil
+}
+
+// NewDerivedAccounts derives num accounts from the seed starting at the given index
+func NewDerivedAccounts(seed []byte, index uint64, num int) ([]*Account, error) {
+	accts := []*Account{}
+	for i := 0; i < num; i++ {
+		acct, err := NewDerivedAccount(seed, index+uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		accts = append(accts, acct)
+	}
+	return accts, nil
+}
+
+// NewDerivedAccount derives the account with the given index from the seed.
+// The BLS key is the signing key of the EIP-2334 path m/12381/3600/index/0/0
+// and the ECDSA key is the one of the BIP-44 path m/44'/60'/0'/0/index.
+func NewDerivedAccount(seed []byte, index uint64) (*Account, error) {
+	if index > 0x7fffffff {
+		return nil, fmt.Errorf("derivation index %d out of range", index)
+	}
+
+	blsKey, err := DeriveBlsKey(seed, []uint32{12381, 3600, uint32(index), 0, 0})
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, err
+	}
+	path := wallet.DerivationPath{0x80000000 + 44, 0x80000000 + 60, 0x80000000 + 0, 0, uint32(index)}
+	priv, err := path.Derive(master)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		Bls:   blsKey,
+		Ecdsa: wallet.NewKey(priv),
+		Index: &index,
+	}
+	return account, nil
+}
+
+// DeriveBlsKey derives the BLS key of the path from the seed (EIP-2333)
+func DeriveBlsKey(seed []byte, path []uint32) (*bls.Key, error) {
+	sk, err := deriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range path {
+		sk = deriveChildSK(sk, index)
+	}
+
+	priv := make([]byte, 32)
+	sk.FillBytes(priv)
+
+	return KeyFromPriv(priv)
+}
+
+// blsCurveOrder is the order (r) of the BLS12-381 curve
+var blsCurveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+func deriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < 32 {
+		return nil, fmt.Errorf("seed of %d bytes, expected at least 32", len(seed))
+	}
+	return hkdfModR(seed), nil
+}
+
+func deriveChildSK(parentSK *big.Int, index uint32) *big.Int {
+	return hkdfModR(parentSKToLamportPK(parentSK, index))
+}
+
+// hkdfModR derives a secret key from the input keying material
+func hkdfModR(ikm []byte) *big.Int {
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	sk := new(big.Int)
+
+	for sk.Sign() == 0 {
+		hash := sha256.Sum256(salt)
+		salt = hash[:]
+
+		// L = 48 is appended as the key info
+		secret := make([]byte, len(ikm)+1)
+		copy(secret, ikm)
+
+		okm := make([]byte, 48)
+		reader := hkdf.New(sha256.New, secret, salt, []byte{0, 48})
+		if _, err := io.ReadFull(reader, okm); err != nil {
+			panic(fmt.Errorf("BUG: failed to expand key: %v", err))
+		}
+		sk.SetBytes(okm)
+		sk.Mod(sk, blsCurveOrder)
+	}
+	return sk
+}
+
+// parentSKToLamportPK returns the compressed lamport public key used
+// to derive the child key of the given index
+func parentSKToLamportPK(parentSK *big.Int, index uint32) []byte {
+	salt := []byte{byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index)}
+
+	ikm := make([]byte, 32)
+	parentSK.FillBytes(ikm)
+
+	notIkm := make([]byte, 32)
+	for i, b := range ikm {
+		notIkm[i] = ^b
+	}
+
+	lamportPK := []byte{}
+	for _, secret := range [][]byte{ikm, notIkm} {
+		okm := make([]byte, 255*32)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, nil), okm); err != nil {
+			panic(fmt.Errorf("BUG: failed to expand lamport key: %v", err))
+		}
+		for i := 0; i < 255; i++ {
+			hash := sha256.Sum256(okm[i*32 : (i+1)*32])
+			lamportPK = append(lamportPK, hash[:]...)
+		}
+	}
+
+	compressed := sha256.Sum256(lamportPK)
+	return compressed[:]
+}
@@ -0,0 +1,87 @@
+package proto
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerive_EIP2333(t *testing.T) {
+	// test vectors of the EIP-2333
+	cases := []struct {
+		seed     string
+		master   string
+		index    uint32
+		childKey string
+	}{
+		{
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			"6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			0,
+			"20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			"3141592653589793238462643383279502884197169399375105820974944592",
+			"29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			3141592653,
+			"25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+
+	for _, c := range cases {
+		seed, err := hex.DecodeString(c.seed)
+		require.NoError(t, err)
+
+		master, err := deriveMasterSK(seed)
+		require.NoError(t, err)
+		assert.Equal(t, c.master, master.String())
+
+		child := deriveChildSK(master, c.index)
+		assert.Equal(t, c.childKey, child.String())
+
+		// the key of the path is the same one derived step by step
+		key, err := DeriveBlsKey(seed, []uint32{c.index})
+		require.NoError(t, err)
+
+		priv, err := key.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, c.childKey, new(big.Int).SetBytes(priv).String())
+	}
+
+	_, err := deriveMasterSK(make([]byte, 31))
+	require.Error(t, err)
+}
+
+func TestDerive_Accounts(t *testing.T) {
+	seed, err := MnemonicToSeed("test test test test test test test test test test test junk")
+	require.NoError(t, err)
+
+	accts, err := NewDerivedAccounts(seed, 5, 2)
+	require.NoError(t, err)
+	require.Len(t, accts, 2)
+
+	assert.Equal(t, uint64(5), *accts[0].Index)
+	assert.Equal(t, uint64(6), *accts[1].Index)
+
+	// the accounts are deterministic
+	acct, err := NewDerivedAccount(seed, 6)
+	require.NoError(t, err)
+	assert.True(t, acct.Bls.Equal(accts[1].Bls))
+	assert.Equal(t, acct.Ecdsa.Address(), accts[1].Ecdsa.Address())
+	assert.False(t, acct.Bls.Equal(accts[0].Bls))
+	assert.NotEmpty(t, acct.Bls.Id)
+
+	// the ECDSA key follows the default ethereum derivation path
+	assert.Equal(t, "0x976EA74026E726554dB657fA54763abd0C3a0aa9", acct.Ecdsa.Address().String())
+
+	stub, err := acct.ToStub()
+	require.NoError(t, err)
+	assert.True(t, stub.Derived)
+	assert.Equal(t, uint64(6), stub.Index)
+
+	_, err = MnemonicToSeed("test test")
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package proto
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+// ReadKeys reads the validator keys of a path. The path is either a raw key
+// file with an hex encoded private key for each line (i.e. tranche_N.txt) or
+// a directory of EIP-2335 keystores. The keystores are in the 'keys' folder
+// of the directory (or in the directory itself) and their passwords in the
+// 'passwords' folder with the same name. If password is set, it is the
+// password of all the keystores.
+func ReadKeys(path string, password string) ([]*bls.Key, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readRawKeys(path)
+	}
+	return readKeystores(path, password)
+}
+
+// KeyFromPriv returns the BLS key of a serialized private key with a new
+// uuid. The key is validated first since the bls library does not check its range.
+func KeyFromPriv(priv []byte) (*bls.Key, error) {
+	if len(priv) != 32 {
+		return nil, fmt.Errorf("private key of %d bytes, expected 32", len(priv))
+	}
+	if num := new(big.Int).SetBytes(priv); num.Sign() == 0 || num.Cmp(blsCurveOrder) >= 0 {
+		return nil, fmt.Errorf("private key out of range")
+	}
+	key, err := bls.NewKeyFromPriv(priv)
+	if err != nil {
+		return nil, err
+	}
+	// the id is the uuid of the key in the keystores
+	if key.Id, err = uuid.GenerateUUID(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func readRawKeys(path string) ([]*bls.Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys := []*bls.Key{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		priv, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key in line %d: %v", i+1, err)
+		}
+		key, err := KeyFromPriv(priv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in line %d: %v", i+1, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", path)
+	}
+	return keys, nil
+}
+
+func readKeystores(dir string, password string) ([]*bls.Key, error) {
+	keysDir := filepath.Join(dir, "keys")
+	if _, err := os.Stat(keysDir); err != nil {
+		keysDir = dir
+	}
+	files, err := filepath.Glob(filepath.Join(keysDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no keystores found in %s", keysDir)
+	}
+	sort.Strings(files)
+
+	keys := []*bls.Key{}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		keyPassword := password
+		if keyPassword == "" {
+			name := strings.TrimSuffix(filepath.Base(file), ".json")
+			data, err := ioutil.ReadFile(filepath.Join(dir, "passwords", name+".txt"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read password of keystore %s: %v", name, err)
+			}
+			keyPassword = strings.TrimSpace(string(data))
+		}
+		key, err := bls.FromKeystore(content, keyPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt keystore %s: %v", filepath.Base(file), err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,66 @@
+package proto
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+func TestReadKeys_Raw(t *testing.T) {
+	keys := []*bls.Key{bls.NewRandomKey(), bls.NewRandomKey()}
+
+	content := ""
+	for _, key := range keys {
+		priv, err := key.Marshal()
+		require.NoError(t, err)
+		content += "0x" + hex.EncodeToString(priv) + "\n"
+	}
+
+	path := filepath.Join(t.TempDir(), "tranche_0.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	found, err := ReadKeys(path, "")
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	for i, key := range keys {
+		assert.True(t, key.Equal(found[i]))
+		assert.NotEmpty(t, found[i].Id)
+	}
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("0x1234"), 0600))
+	_, err = ReadKeys(path, "")
+	require.Error(t, err)
+}
+
+func TestReadKeys_Keystores(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "keys"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "passwords"), 0700))
+
+	key := bls.NewRandomKey()
+	keystore, err := bls.ToKeystore(key, "secret")
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "keys", "keystore-0.json"), keystore, 0600))
+
+	// the password file of the keystore is required
+	_, err = ReadKeys(dir, "")
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "passwords", "keystore-0.txt"), []byte("secret\n"), 0600))
+
+	found, err := ReadKeys(dir, "")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.True(t, key.Equal(found[0]))
+
+	// the password overrides the password files
+	_, err = ReadKeys(dir, "other")
+	require.Error(t, err)
+}
@@ -1,13 +1,12 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
+// 	protoc-gen-go v1.28.0
 // 	protoc        v3.12.0
 // source: internal/server/proto/service.proto
 
 package proto
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,9 +20,271 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
+type ExportFormat int32
+
+const (
+	// EIP-2335 keystores with a password file for each one
+	ExportFormat_Keystores ExportFormat = 0
+	// deposit_data.json file of the staking launchpad
+	ExportFormat_DepositData ExportFormat = 1
+	// imported prysm wallet
+	ExportFormat_PrysmWallet ExportFormat = 2
+	// hex encoded private keys
+	ExportFormat_Raw ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "Keystores",
+		1: "DepositData",
+		2: "PrysmWallet",
+		3: "Raw",
+	}
+	ExportFormat_value = map[string]int32{
+		"Keystores":   0,
+		"DepositData": 1,
+		"PrysmWallet": 2,
+		"Raw":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{0}
+}
+
+type InvalidDeposit int32
+
+const (
+	InvalidDeposit_NoInvalidDeposit InvalidDeposit = 0
+	// deposit signed with another key
+	InvalidDeposit_InvalidSignature InvalidDeposit = 1
+	// deposit with a data root that does not match the deposit data.
+	// The transaction is reverted by the deposit contract.
+	InvalidDeposit_InvalidRoot InvalidDeposit = 2
+	// second deposit for the same key with other withdrawal credentials
+	InvalidDeposit_InvalidDuplicate InvalidDeposit = 3
+	// deposit lower than the minimum amount of the deposit contract.
+	// The transaction is reverted by the deposit contract.
+	InvalidDeposit_InvalidAmount InvalidDeposit = 4
+)
+
+// Enum value maps for InvalidDeposit.
+var (
+	InvalidDeposit_name = map[int32]string{
+		0: "NoInvalidDeposit",
+		1: "InvalidSignature",
+		2: "InvalidRoot",
+		3: "InvalidDuplicate",
+		4: "InvalidAmount",
+	}
+	InvalidDeposit_value = map[string]int32{
+		"NoInvalidDeposit": 0,
+		"InvalidSignature": 1,
+		"InvalidRoot":      2,
+		"InvalidDuplicate": 3,
+		"InvalidAmount":    4,
+	}
+)
+
+func (x InvalidDeposit) Enum() *InvalidDeposit {
+	p := new(InvalidDeposit)
+	*p = x
+	return p
+}
+
+func (x InvalidDeposit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvalidDeposit) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[1].Descriptor()
+}
+
+func (InvalidDeposit) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[1]
+}
+
+func (x InvalidDeposit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvalidDeposit.Descriptor instead.
+func (InvalidDeposit) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{1}
+}
+
+type WithdrawalCredentials int32
+
+const (
+	// 0x00 credentials with the BLS public key of the validator
+	WithdrawalCredentials_BlsCredentials WithdrawalCredentials = 0
+	// 0x01 credentials with an execution address
+	WithdrawalCredentials_ExecutionCredentials WithdrawalCredentials = 1
+)
+
+// Enum value maps for WithdrawalCredentials.
+var (
+	WithdrawalCredentials_name = map[int32]string{
+		0: "BlsCredentials",
+		1: "ExecutionCredentials",
+	}
+	WithdrawalCredentials_value = map[string]int32{
+		"BlsCredentials":       0,
+		"ExecutionCredentials": 1,
+	}
+)
+
+func (x WithdrawalCredentials) Enum() *WithdrawalCredentials {
+	p := new(WithdrawalCredentials)
+	*p = x
+	return p
+}
+
+func (x WithdrawalCredentials) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WithdrawalCredentials) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[2].Descriptor()
+}
+
+func (WithdrawalCredentials) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[2]
+}
+
+func (x WithdrawalCredentials) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WithdrawalCredentials.Descriptor instead.
+func (WithdrawalCredentials) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{2}
+}
+
+type NodeState int32
+
+const (
+	NodeState_Running    NodeState = 0
+	NodeState_Exited     NodeState = 1
+	NodeState_Restarting NodeState = 2
+	NodeState_Stopped    NodeState = 3
+)
+
+// Enum value maps for NodeState.
+var (
+	NodeState_name = map[int32]string{
+		0: "Running",
+		1: "Exited",
+		2: "Restarting",
+		3: "Stopped",
+	}
+	NodeState_value = map[string]int32{
+		"Running":    0,
+		"Exited":     1,
+		"Restarting": 2,
+		"Stopped":    3,
+	}
+)
+
+func (x NodeState) Enum() *NodeState {
+	p := new(NodeState)
+	*p = x
+	return p
+}
+
+func (x NodeState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[3].Descriptor()
+}
+
+func (NodeState) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[3]
+}
+
+func (x NodeState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeState.Descriptor instead.
+func (NodeState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{3}
+}
+
+type RestartPolicy int32
+
+const (
+	RestartPolicy_Never     RestartPolicy = 0
+	RestartPolicy_OnFailure RestartPolicy = 1
+	RestartPolicy_Always    RestartPolicy = 2
+)
+
+// Enum value maps for RestartPolicy.
+var (
+	RestartPolicy_name = map[int32]string{
+		0: "Never",
+		1: "OnFailure",
+		2: "Always",
+	}
+	RestartPolicy_value = map[string]int32{
+		"Never":     0,
+		"OnFailure": 1,
+		"Always":    2,
+	}
+)
+
+func (x RestartPolicy) Enum() *RestartPolicy {
+	p := new(RestartPolicy)
+	*p = x
+	return p
+}
+
+func (x RestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[4].Descriptor()
+}
+
+func (RestartPolicy) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[4]
+}
+
+func (x RestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartPolicy.Descriptor instead.
+func (RestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{4}
+}
 
 type NodeType int32
 
@@ -32,6 +293,8 @@ const (
 	NodeType_Beacon    NodeType = 1
 	NodeType_Validator NodeType = 2
 	NodeType_Bootnode  NodeType = 3
+	NodeType_Execution NodeType = 4
+	NodeType_Signer    NodeType = 5
 )
 
 // Enum value maps for NodeType.
@@ -41,12 +304,16 @@ var (
 		1: "Beacon",
 		2: "Validator",
 		3: "Bootnode",
+		4: "Execution",
+		5: "Signer",
 	}
 	NodeType_value = map[string]int32{
 		"OtherType": 0,
 		"Beacon":    1,
 		"Validator": 2,
 		"Bootnode":  3,
+		"Execution": 4,
+		"Signer":    5,
 	}
 )
 
@@ -61,11 +328,11 @@ func (x NodeType) String() string {
 }
 
 func (NodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_server_proto_service_proto_enumTypes[0].Descriptor()
+	return file_internal_server_proto_service_proto_enumTypes[5].Descriptor()
 }
 
 func (NodeType) Type() protoreflect.EnumType {
-	return &file_internal_server_proto_service_proto_enumTypes[0]
+	return &file_internal_server_proto_service_proto_enumTypes[5]
 }
 
 func (x NodeType) Number() protoreflect.EnumNumber {
@@ -74,7 +341,7 @@ func (x NodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NodeType.Descriptor instead.
 func (NodeType) EnumDescriptor() ([]byte, []int) {
-	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{0}
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{5}
 }
 
 type NodeClient int32
@@ -84,6 +351,8 @@ const (
 	NodeClient_Prysm       NodeClient = 1
 	NodeClient_Teku        NodeClient = 2
 	NodeClient_Lighthouse  NodeClient = 3
+	NodeClient_Nimbus      NodeClient = 4
+	NodeClient_Lodestar    NodeClient = 5
 )
 
 // Enum value maps for NodeClient.
@@ -93,12 +362,16 @@ var (
 		1: "Prysm",
 		2: "Teku",
 		3: "Lighthouse",
+		4: "Nimbus",
+		5: "Lodestar",
 	}
 	NodeClient_value = map[string]int32{
 		"OtherClient": 0,
 		"Prysm":       1,
 		"Teku":        2,
 		"Lighthouse":  3,
+		"Nimbus":      4,
+		"Lodestar":    5,
 	}
 )
 
@@ -113,11 +386,11 @@ func (x NodeClient) String() string {
 }
 
 func (NodeClient) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_server_proto_service_proto_enumTypes[1].Descriptor()
+	return file_internal_server_proto_service_proto_enumTypes[6].Descriptor()
 }
 
 func (NodeClient) Type() protoreflect.EnumType {
-	return &file_internal_server_proto_service_proto_enumTypes[1]
+	return &file_internal_server_proto_service_proto_enumTypes[6]
 }
 
 func (x NodeClient) Number() protoreflect.EnumNumber {
@@ -126,7 +399,59 @@ func (x NodeClient) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NodeClient.Descriptor instead.
 func (NodeClient) EnumDescriptor() ([]byte, []int) {
-	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{1}
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ExecutionClient int32
+
+const (
+	ExecutionClient_Geth       ExecutionClient = 0
+	ExecutionClient_Nethermind ExecutionClient = 1
+	ExecutionClient_Besu       ExecutionClient = 2
+	ExecutionClient_Erigon     ExecutionClient = 3
+)
+
+// Enum value maps for ExecutionClient.
+var (
+	ExecutionClient_name = map[int32]string{
+		0: "Geth",
+		1: "Nethermind",
+		2: "Besu",
+		3: "Erigon",
+	}
+	ExecutionClient_value = map[string]int32{
+		"Geth":       0,
+		"Nethermind": 1,
+		"Besu":       2,
+		"Erigon":     3,
+	}
+)
+
+func (x ExecutionClient) Enum() *ExecutionClient {
+	p := new(ExecutionClient)
+	*p = x
+	return p
+}
+
+func (x ExecutionClient) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionClient) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_server_proto_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ExecutionClient) Type() protoreflect.EnumType {
+	return &file_internal_server_proto_service_proto_enumTypes[7]
+}
+
+func (x ExecutionClient) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionClient.Descriptor instead.
+func (ExecutionClient) EnumDescriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
 type Fork int32
@@ -162,11 +487,11 @@ func (x Fork) String() string {
 }
 
 func (Fork) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_server_proto_service_proto_enumTypes[2].Descriptor()
+	return file_internal_server_proto_service_proto_enumTypes[8].Descriptor()
 }
 
 func (Fork) Type() protoreflect.EnumType {
-	return &file_internal_server_proto_service_proto_enumTypes[2]
+	return &file_internal_server_proto_service_proto_enumTypes[8]
 }
 
 func (x Fork) Number() protoreflect.EnumNumber {
@@ -175,7 +500,7 @@ func (x Fork) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Fork.Descriptor instead.
 func (Fork) EnumDescriptor() ([]byte, []int) {
-	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{2}
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{8}
 }
 
 type DepositListRequest struct {
@@ -263,16 +588,20 @@ func (x *DepositListResponse) GetTranches() []*TrancheStub {
 	return nil
 }
 
-type DepositCreateRequest struct {
+type DepositExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumValidators uint64 `protobuf:"varint,1,opt,name=numValidators,proto3" json:"numValidators,omitempty"`
+	Tranche uint64       `protobuf:"varint,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	Format  ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=proto.ExportFormat" json:"format,omitempty"`
+	// password of the keystores and the prysm wallet. A random
+	// password is generated if it is empty.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *DepositCreateRequest) Reset() {
-	*x = DepositCreateRequest{}
+func (x *DepositExportRequest) Reset() {
+	*x = DepositExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,13 +609,13 @@ func (x *DepositCreateRequest) Reset() {
 	}
 }
 
-func (x *DepositCreateRequest) String() string {
+func (x *DepositExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositCreateRequest) ProtoMessage() {}
+func (*DepositExportRequest) ProtoMessage() {}
 
-func (x *DepositCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,28 +627,42 @@ func (x *DepositCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositCreateRequest.ProtoReflect.Descriptor instead.
-func (*DepositCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositExportRequest.ProtoReflect.Descriptor instead.
+func (*DepositExportRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DepositCreateRequest) GetNumValidators() uint64 {
+func (x *DepositExportRequest) GetTranche() uint64 {
 	if x != nil {
-		return x.NumValidators
+		return x.Tranche
 	}
 	return 0
 }
 
-type DepositCreateResponse struct {
+func (x *DepositExportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_Keystores
+}
+
+func (x *DepositExportRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DepositExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tranche *TrancheStub `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	Files []*DepositExportResponse_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *DepositCreateResponse) Reset() {
-	*x = DepositCreateResponse{}
+func (x *DepositExportResponse) Reset() {
+	*x = DepositExportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -327,13 +670,13 @@ func (x *DepositCreateResponse) Reset() {
 	}
 }
 
-func (x *DepositCreateResponse) String() string {
+func (x *DepositExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositCreateResponse) ProtoMessage() {}
+func (*DepositExportResponse) ProtoMessage() {}
 
-func (x *DepositCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *DepositExportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,35 +688,29 @@ func (x *DepositCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositCreateResponse.ProtoReflect.Descriptor instead.
-func (*DepositCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositExportResponse.ProtoReflect.Descriptor instead.
+func (*DepositExportResponse) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DepositCreateResponse) GetTranche() *TrancheStub {
+func (x *DepositExportResponse) GetFiles() []*DepositExportResponse_File {
 	if x != nil {
-		return x.Tranche
+		return x.Files
 	}
 	return nil
 }
 
-type NodeDeployRequest struct {
+type DepositImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	NodeClient NodeClient `protobuf:"varint,2,opt,name=nodeClient,proto3,enum=proto.NodeClient" json:"nodeClient,omitempty"`
-	Repo       string     `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
-	Tag        string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
-	// Types that are assignable to NodeType:
-	//	*NodeDeployRequest_Beacon_
-	//	*NodeDeployRequest_Validator_
-	NodeType isNodeDeployRequest_NodeType `protobuf_oneof:"NodeType"`
+	// private keys of the validators
+	Keys [][]byte `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *NodeDeployRequest) Reset() {
-	*x = NodeDeployRequest{}
+func (x *DepositImportRequest) Reset() {
+	*x = DepositImportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,13 +718,13 @@ func (x *NodeDeployRequest) Reset() {
 	}
 }
 
-func (x *NodeDeployRequest) String() string {
+func (x *DepositImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDeployRequest) ProtoMessage() {}
+func (*DepositImportRequest) ProtoMessage() {}
 
-func (x *NodeDeployRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositImportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,100 +736,42 @@ func (x *NodeDeployRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDeployRequest.ProtoReflect.Descriptor instead.
-func (*NodeDeployRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositImportRequest.ProtoReflect.Descriptor instead.
+func (*DepositImportRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *NodeDeployRequest) GetName() string {
+func (x *DepositImportRequest) GetKeys() [][]byte {
 	if x != nil {
-		return x.Name
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeDeployRequest) GetNodeClient() NodeClient {
-	if x != nil {
-		return x.NodeClient
-	}
-	return NodeClient_OtherClient
-}
+type DepositImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *NodeDeployRequest) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
+	Tranche *TrancheStub `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
 }
 
-func (x *NodeDeployRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
+func (x *DepositImportResponse) Reset() {
+	*x = DepositImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (m *NodeDeployRequest) GetNodeType() isNodeDeployRequest_NodeType {
-	if m != nil {
-		return m.NodeType
-	}
-	return nil
+func (x *DepositImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NodeDeployRequest) GetBeacon() *NodeDeployRequest_Beacon {
-	if x, ok := x.GetNodeType().(*NodeDeployRequest_Beacon_); ok {
-		return x.Beacon
-	}
-	return nil
-}
-
-func (x *NodeDeployRequest) GetValidator() *NodeDeployRequest_Validator {
-	if x, ok := x.GetNodeType().(*NodeDeployRequest_Validator_); ok {
-		return x.Validator
-	}
-	return nil
-}
-
-type isNodeDeployRequest_NodeType interface {
-	isNodeDeployRequest_NodeType()
-}
-
-type NodeDeployRequest_Beacon_ struct {
-	Beacon *NodeDeployRequest_Beacon `protobuf:"bytes,20,opt,name=beacon,proto3,oneof"`
-}
-
-type NodeDeployRequest_Validator_ struct {
-	Validator *NodeDeployRequest_Validator `protobuf:"bytes,21,opt,name=validator,proto3,oneof"`
-}
-
-func (*NodeDeployRequest_Beacon_) isNodeDeployRequest_NodeType() {}
-
-func (*NodeDeployRequest_Validator_) isNodeDeployRequest_NodeType() {}
-
-type NodeDeployResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (*DepositImportResponse) ProtoMessage() {}
 
-	Nodes []*Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-}
-
-func (x *NodeDeployResponse) Reset() {
-	*x = NodeDeployResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_server_proto_service_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *NodeDeployResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NodeDeployResponse) ProtoMessage() {}
-
-func (x *NodeDeployResponse) ProtoReflect() protoreflect.Message {
+func (x *DepositImportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,26 +783,34 @@ func (x *NodeDeployResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDeployResponse.ProtoReflect.Descriptor instead.
-func (*NodeDeployResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositImportResponse.ProtoReflect.Descriptor instead.
+func (*DepositImportResponse) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *NodeDeployResponse) GetNodes() []*Node {
+func (x *DepositImportResponse) GetTranche() *TrancheStub {
 	if x != nil {
-		return x.Nodes
+		return x.Tranche
 	}
 	return nil
 }
 
-type NodeListRequest struct {
+type DepositExitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tranche uint64 `protobuf:"varint,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	// indices are the indices of the validators of the tranche
+	// to exit. All the validators are exited if it is empty.
+	Indices []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	// beacon is the name of the beacon node used to submit the exits.
+	// If empty, any beacon node is used.
+	Beacon string `protobuf:"bytes,3,opt,name=beacon,proto3" json:"beacon,omitempty"`
 }
 
-func (x *NodeListRequest) Reset() {
-	*x = NodeListRequest{}
+func (x *DepositExitRequest) Reset() {
+	*x = DepositExitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,13 +818,13 @@ func (x *NodeListRequest) Reset() {
 	}
 }
 
-func (x *NodeListRequest) String() string {
+func (x *DepositExitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeListRequest) ProtoMessage() {}
+func (*DepositExitRequest) ProtoMessage() {}
 
-func (x *NodeListRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositExitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,21 +836,44 @@ func (x *NodeListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeListRequest.ProtoReflect.Descriptor instead.
-func (*NodeListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositExitRequest.ProtoReflect.Descriptor instead.
+func (*DepositExitRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{6}
 }
 
-type NodeListResponse struct {
+func (x *DepositExitRequest) GetTranche() uint64 {
+	if x != nil {
+		return x.Tranche
+	}
+	return 0
+}
+
+func (x *DepositExitRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *DepositExitRequest) GetBeacon() string {
+	if x != nil {
+		return x.Beacon
+	}
+	return ""
+}
+
+// DepositExitResponse is sent with the status of the validators every time it changes
+// until all of them are exited
+type DepositExitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node []*Node `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Validators []*DepositExitResponse_Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *NodeListResponse) Reset() {
-	*x = NodeListResponse{}
+func (x *DepositExitResponse) Reset() {
+	*x = DepositExitResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,13 +881,13 @@ func (x *NodeListResponse) Reset() {
 	}
 }
 
-func (x *NodeListResponse) String() string {
+func (x *DepositExitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeListResponse) ProtoMessage() {}
+func (*DepositExitResponse) ProtoMessage() {}
 
-func (x *NodeListResponse) ProtoReflect() protoreflect.Message {
+func (x *DepositExitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,28 +899,40 @@ func (x *NodeListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeListResponse.ProtoReflect.Descriptor instead.
-func (*NodeListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositExitResponse.ProtoReflect.Descriptor instead.
+func (*DepositExitResponse) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *NodeListResponse) GetNode() []*Node {
+func (x *DepositExitResponse) GetValidators() []*DepositExitResponse_Validator {
 	if x != nil {
-		return x.Node
+		return x.Validators
 	}
 	return nil
 }
 
-type NodeStatusRequest struct {
+type DepositCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumValidators uint64 `protobuf:"varint,1,opt,name=numValidators,proto3" json:"numValidators,omitempty"`
+	// amount is the value of each deposit in gwei. It defaults
+	// to the deposit of a validator with the max effective balance.
+	Amount                uint64                `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithdrawalCredentials WithdrawalCredentials `protobuf:"varint,3,opt,name=withdrawalCredentials,proto3,enum=proto.WithdrawalCredentials" json:"withdrawalCredentials,omitempty"`
+	// withdrawalAddress is the address of the execution withdrawal credentials
+	WithdrawalAddress string `protobuf:"bytes,4,opt,name=withdrawalAddress,proto3" json:"withdrawalAddress,omitempty"`
+	// topUp makes the deposits for the validators of an existing tranche
+	// instead of creating a new one
+	TopUp   bool   `protobuf:"varint,5,opt,name=topUp,proto3" json:"topUp,omitempty"`
+	Tranche uint64 `protobuf:"varint,6,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	// invalid makes deliberately invalid deposits for the new validators
+	Invalid InvalidDeposit `protobuf:"varint,7,opt,name=invalid,proto3,enum=proto.InvalidDeposit" json:"invalid,omitempty"`
 }
 
-func (x *NodeStatusRequest) Reset() {
-	*x = NodeStatusRequest{}
+func (x *DepositCreateRequest) Reset() {
+	*x = DepositCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -618,13 +940,13 @@ func (x *NodeStatusRequest) Reset() {
 	}
 }
 
-func (x *NodeStatusRequest) String() string {
+func (x *DepositCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeStatusRequest) ProtoMessage() {}
+func (*DepositCreateRequest) ProtoMessage() {}
 
-func (x *NodeStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,28 +958,70 @@ func (x *NodeStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeStatusRequest.ProtoReflect.Descriptor instead.
-func (*NodeStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositCreateRequest.ProtoReflect.Descriptor instead.
+func (*DepositCreateRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NodeStatusRequest) GetName() string {
+func (x *DepositCreateRequest) GetNumValidators() uint64 {
 	if x != nil {
-		return x.Name
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *DepositCreateRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepositCreateRequest) GetWithdrawalCredentials() WithdrawalCredentials {
+	if x != nil {
+		return x.WithdrawalCredentials
+	}
+	return WithdrawalCredentials_BlsCredentials
+}
+
+func (x *DepositCreateRequest) GetWithdrawalAddress() string {
+	if x != nil {
+		return x.WithdrawalAddress
 	}
 	return ""
 }
 
-type NodeStatusResponse struct {
+func (x *DepositCreateRequest) GetTopUp() bool {
+	if x != nil {
+		return x.TopUp
+	}
+	return false
+}
+
+func (x *DepositCreateRequest) GetTranche() uint64 {
+	if x != nil {
+		return x.Tranche
+	}
+	return 0
+}
+
+func (x *DepositCreateRequest) GetInvalid() InvalidDeposit {
+	if x != nil {
+		return x.Invalid
+	}
+	return InvalidDeposit_NoInvalidDeposit
+}
+
+type DepositCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node *Node `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Tranche *TrancheStub `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
 }
 
-func (x *NodeStatusResponse) Reset() {
-	*x = NodeStatusResponse{}
+func (x *DepositCreateResponse) Reset() {
+	*x = DepositCreateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +1029,13 @@ func (x *NodeStatusResponse) Reset() {
 	}
 }
 
-func (x *NodeStatusResponse) String() string {
+func (x *DepositCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeStatusResponse) ProtoMessage() {}
+func (*DepositCreateResponse) ProtoMessage() {}
 
-func (x *NodeStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *DepositCreateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,32 +1047,43 @@ func (x *NodeStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeStatusResponse.ProtoReflect.Descriptor instead.
-func (*NodeStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositCreateResponse.ProtoReflect.Descriptor instead.
+func (*DepositCreateResponse) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *NodeStatusResponse) GetNode() *Node {
+func (x *DepositCreateResponse) GetTranche() *TrancheStub {
 	if x != nil {
-		return x.Node
+		return x.Tranche
 	}
 	return nil
 }
 
-type Node struct {
+type NodeDeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type   NodeType          `protobuf:"varint,2,opt,name=type,proto3,enum=proto.NodeType" json:"type,omitempty"`
-	Client NodeClient        `protobuf:"varint,3,opt,name=client,proto3,enum=proto.NodeClient" json:"client,omitempty"`
-	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Ip     string            `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeClient NodeClient `protobuf:"varint,2,opt,name=nodeClient,proto3,enum=proto.NodeClient" json:"nodeClient,omitempty"`
+	Repo       string     `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tag        string     `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// restartPolicy is the policy to restart the nodes once they exit
+	RestartPolicy RestartPolicy `protobuf:"varint,5,opt,name=restartPolicy,proto3,enum=proto.RestartPolicy" json:"restartPolicy,omitempty"`
+	// maxRetries is the maximum number of restarts with the OnFailure
+	// policy. Zero means that there is no limit.
+	MaxRetries uint64 `protobuf:"varint,6,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	// executionClient is the client of the execution nodes
+	ExecutionClient ExecutionClient `protobuf:"varint,7,opt,name=executionClient,proto3,enum=proto.ExecutionClient" json:"executionClient,omitempty"`
+	// Types that are assignable to NodeType:
+	//	*NodeDeployRequest_Beacon_
+	//	*NodeDeployRequest_Validator_
+	//	*NodeDeployRequest_Execution_
+	NodeType isNodeDeployRequest_NodeType `protobuf_oneof:"NodeType"`
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
+func (x *NodeDeployRequest) Reset() {
+	*x = NodeDeployRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,13 +1091,13 @@ func (x *Node) Reset() {
 	}
 }
 
-func (x *Node) String() string {
+func (x *NodeDeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*NodeDeployRequest) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
+func (x *NodeDeployRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,72 +1109,135 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeDeployRequest.ProtoReflect.Descriptor instead.
+func (*NodeDeployRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Node) GetName() string {
+func (x *NodeDeployRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Node) GetType() NodeType {
+func (x *NodeDeployRequest) GetNodeClient() NodeClient {
 	if x != nil {
-		return x.Type
+		return x.NodeClient
 	}
-	return NodeType_OtherType
+	return NodeClient_OtherClient
 }
 
-func (x *Node) GetClient() NodeClient {
+func (x *NodeDeployRequest) GetRepo() string {
 	if x != nil {
-		return x.Client
+		return x.Repo
 	}
-	return NodeClient_OtherClient
+	return ""
 }
 
-func (x *Node) GetLabels() map[string]string {
+func (x *NodeDeployRequest) GetTag() string {
 	if x != nil {
-		return x.Labels
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *Node) GetIp() string {
+func (x *NodeDeployRequest) GetRestartPolicy() RestartPolicy {
 	if x != nil {
-		return x.Ip
+		return x.RestartPolicy
 	}
-	return ""
+	return RestartPolicy_Never
 }
 
-type AccountStub struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *NodeDeployRequest) GetMaxRetries() uint64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
 
-	PrivKey string `protobuf:"bytes,1,opt,name=privKey,proto3" json:"privKey,omitempty"`
-	PubKey  string `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+func (x *NodeDeployRequest) GetExecutionClient() ExecutionClient {
+	if x != nil {
+		return x.ExecutionClient
+	}
+	return ExecutionClient_Geth
 }
 
-func (x *AccountStub) Reset() {
-	*x = AccountStub{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_server_proto_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *NodeDeployRequest) GetNodeType() isNodeDeployRequest_NodeType {
+	if m != nil {
+		return m.NodeType
 	}
+	return nil
 }
 
-func (x *AccountStub) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeDeployRequest) GetBeacon() *NodeDeployRequest_Beacon {
+	if x, ok := x.GetNodeType().(*NodeDeployRequest_Beacon_); ok {
+		return x.Beacon
+	}
+	return nil
 }
 
-func (*AccountStub) ProtoMessage() {}
+func (x *NodeDeployRequest) GetValidator() *NodeDeployRequest_Validator {
+	if x, ok := x.GetNodeType().(*NodeDeployRequest_Validator_); ok {
+		return x.Validator
+	}
+	return nil
+}
 
-func (x *AccountStub) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_server_proto_service_proto_msgTypes[11]
+func (x *NodeDeployRequest) GetExecution() *NodeDeployRequest_Execution {
+	if x, ok := x.GetNodeType().(*NodeDeployRequest_Execution_); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+type isNodeDeployRequest_NodeType interface {
+	isNodeDeployRequest_NodeType()
+}
+
+type NodeDeployRequest_Beacon_ struct {
+	Beacon *NodeDeployRequest_Beacon `protobuf:"bytes,20,opt,name=beacon,proto3,oneof"`
+}
+
+type NodeDeployRequest_Validator_ struct {
+	Validator *NodeDeployRequest_Validator `protobuf:"bytes,21,opt,name=validator,proto3,oneof"`
+}
+
+type NodeDeployRequest_Execution_ struct {
+	Execution *NodeDeployRequest_Execution `protobuf:"bytes,22,opt,name=execution,proto3,oneof"`
+}
+
+func (*NodeDeployRequest_Beacon_) isNodeDeployRequest_NodeType() {}
+
+func (*NodeDeployRequest_Validator_) isNodeDeployRequest_NodeType() {}
+
+func (*NodeDeployRequest_Execution_) isNodeDeployRequest_NodeType() {}
+
+type NodeDeployResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *NodeDeployResponse) Reset() {
+	*x = NodeDeployResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDeployResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDeployResponse) ProtoMessage() {}
+
+func (x *NodeDeployResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,38 +1248,37 @@ func (x *AccountStub) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountStub.ProtoReflect.Descriptor instead.
-func (*AccountStub) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeDeployResponse.ProtoReflect.Descriptor instead.
+func (*NodeDeployResponse) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AccountStub) GetPrivKey() string {
-	if x != nil {
-		return x.PrivKey
-	}
-	return ""
-}
-
-func (x *AccountStub) GetPubKey() string {
+func (x *NodeDeployResponse) GetNodes() []*Node {
 	if x != nil {
-		return x.PubKey
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-type TrancheStub struct {
+type ValidatorMigrateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index    uint64         `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Accounts []*AccountStub `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	Name     string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Path     string         `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// name of the validator node to migrate
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// nodeClient is the client of the new validator
+	NodeClient NodeClient `protobuf:"varint,2,opt,name=nodeClient,proto3,enum=proto.NodeClient" json:"nodeClient,omitempty"`
+	// name of the beacon node the new validator connects to
+	Beacon        string        `protobuf:"bytes,3,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	Repo          string        `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Tag           string        `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	RestartPolicy RestartPolicy `protobuf:"varint,6,opt,name=restartPolicy,proto3,enum=proto.RestartPolicy" json:"restartPolicy,omitempty"`
+	MaxRetries    uint64        `protobuf:"varint,7,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
 }
 
-func (x *TrancheStub) Reset() {
-	*x = TrancheStub{}
+func (x *ValidatorMigrateRequest) Reset() {
+	*x = ValidatorMigrateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,13 +1286,13 @@ func (x *TrancheStub) Reset() {
 	}
 }
 
-func (x *TrancheStub) String() string {
+func (x *ValidatorMigrateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrancheStub) ProtoMessage() {}
+func (*ValidatorMigrateRequest) ProtoMessage() {}
 
-func (x *TrancheStub) ProtoReflect() protoreflect.Message {
+func (x *ValidatorMigrateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,49 +1304,73 @@ func (x *TrancheStub) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrancheStub.ProtoReflect.Descriptor instead.
-func (*TrancheStub) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorMigrateRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorMigrateRequest) Descriptor() ([]byte, []int) {
 	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TrancheStub) GetIndex() uint64 {
+func (x *ValidatorMigrateRequest) GetName() string {
 	if x != nil {
-		return x.Index
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *TrancheStub) GetAccounts() []*AccountStub {
+func (x *ValidatorMigrateRequest) GetNodeClient() NodeClient {
 	if x != nil {
-		return x.Accounts
+		return x.NodeClient
 	}
-	return nil
+	return NodeClient_OtherClient
 }
 
-func (x *TrancheStub) GetName() string {
+func (x *ValidatorMigrateRequest) GetBeacon() string {
 	if x != nil {
-		return x.Name
+		return x.Beacon
 	}
 	return ""
 }
 
-func (x *TrancheStub) GetPath() string {
+func (x *ValidatorMigrateRequest) GetRepo() string {
 	if x != nil {
-		return x.Path
+		return x.Repo
 	}
 	return ""
 }
 
-type NodeDeployRequest_Beacon struct {
+func (x *ValidatorMigrateRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ValidatorMigrateRequest) GetRestartPolicy() RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return RestartPolicy_Never
+}
+
+func (x *ValidatorMigrateRequest) GetMaxRetries() uint64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+type ValidatorMigrateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Node *Node `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// slashingProtection is the EIP-3076 interchange file
+	// exported from the previous validator
+	SlashingProtection []byte `protobuf:"bytes,2,opt,name=slashingProtection,proto3" json:"slashingProtection,omitempty"`
 }
 
-func (x *NodeDeployRequest_Beacon) Reset() {
-	*x = NodeDeployRequest_Beacon{}
+func (x *ValidatorMigrateResponse) Reset() {
+	*x = ValidatorMigrateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,13 +1378,13 @@ func (x *NodeDeployRequest_Beacon) Reset() {
 	}
 }
 
-func (x *NodeDeployRequest_Beacon) String() string {
+func (x *ValidatorMigrateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDeployRequest_Beacon) ProtoMessage() {}
+func (*ValidatorMigrateResponse) ProtoMessage() {}
 
-func (x *NodeDeployRequest_Beacon) ProtoReflect() protoreflect.Message {
+func (x *ValidatorMigrateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,31 +1396,33 @@ func (x *NodeDeployRequest_Beacon) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDeployRequest_Beacon.ProtoReflect.Descriptor instead.
-func (*NodeDeployRequest_Beacon) Descriptor() ([]byte, []int) {
-	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ValidatorMigrateResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorMigrateResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *NodeDeployRequest_Beacon) GetCount() uint64 {
+func (x *ValidatorMigrateResponse) GetNode() *Node {
 	if x != nil {
-		return x.Count
+		return x.Node
 	}
-	return 0
+	return nil
 }
 
-type NodeDeployRequest_Validator struct {
+func (x *ValidatorMigrateResponse) GetSlashingProtection() []byte {
+	if x != nil {
+		return x.SlashingProtection
+	}
+	return nil
+}
+
+type NodeListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NumValidators uint64 `protobuf:"varint,1,opt,name=numValidators,proto3" json:"numValidators,omitempty"`
-	NumTranch     uint64 `protobuf:"varint,2,opt,name=numTranch,proto3" json:"numTranch,omitempty"`
-	WithBeacon    bool   `protobuf:"varint,3,opt,name=withBeacon,proto3" json:"withBeacon,omitempty"`
-	BeaconCount   uint64 `protobuf:"varint,4,opt,name=beaconCount,proto3" json:"beaconCount,omitempty"`
 }
 
-func (x *NodeDeployRequest_Validator) Reset() {
-	*x = NodeDeployRequest_Validator{}
+func (x *NodeListRequest) Reset() {
+	*x = NodeListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_server_proto_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,257 +1430,3175 @@ func (x *NodeDeployRequest_Validator) Reset() {
 	}
 }
 
-func (x *NodeDeployRequest_Validator) String() string {
+func (x *NodeListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDeployRequest_Validator) ProtoMessage() {}
+func (*NodeListRequest) ProtoMessage() {}
 
-func (x *NodeDeployRequest_Validator) ProtoReflect() protoreflect.Message {
+func (x *NodeListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_server_proto_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NodeDeployRequest_Validator.ProtoReflect.Descriptor instead.
-func (*NodeDeployRequest_Validator) Descriptor() ([]byte, []int) {
-	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *NodeDeployRequest_Validator) GetNumValidators() uint64 {
-	if x != nil {
-		return x.NumValidators
-	}
-	return 0
-}
-
-func (x *NodeDeployRequest_Validator) GetNumTranch() uint64 {
-	if x != nil {
-		return x.NumTranch
-	}
-	return 0
-}
-
-func (x *NodeDeployRequest_Validator) GetWithBeacon() bool {
-	if x != nil {
-		return x.WithBeacon
-	}
-	return false
-}
-
-func (x *NodeDeployRequest_Validator) GetBeaconCount() uint64 {
-	if x != nil {
-		return x.BeaconCount
-	}
-	return 0
-}
-
-var File_internal_server_proto_service_proto protoreflect.FileDescriptor
-
-var file_internal_server_proto_service_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x53, 0x74, 0x75, 0x62, 0x52,
-	0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x65, 0x53, 0x74, 0x75, 0x62, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x22, 0xbf,
-	0x03, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x12, 0x39, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x09,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x1a, 0x1e, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0x91, 0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24,
-	0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x37, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x4e, 0x6f, 0x64,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x10,
-	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x22, 0x27, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x22, 0xe6, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2f,
-	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x1a,
-	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x75, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
-	0x76, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
-	0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x7b, 0x0a, 0x0b, 0x54,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x53, 0x74, 0x75, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x2e, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x53, 0x74, 0x75, 0x62, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x2a, 0x42, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x10, 0x01, 0x12,
-	0x0d, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x0a,
-	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x74,
-	0x68, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50,
-	0x72, 0x79, 0x73, 0x6d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x65, 0x6b, 0x75, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x10, 0x03,
-	0x2a, 0x29, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x68, 0x61, 0x73,
-	0x65, 0x30, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x6c, 0x74, 0x61, 0x69, 0x72, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x10, 0x02, 0x32, 0xe1, 0x02, 0x0a, 0x0a,
-	0x45, 0x32, 0x45, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
-	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3b, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
-	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x18, 0x5a, 0x16, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-}
-
-var (
-	file_internal_server_proto_service_proto_rawDescOnce sync.Once
-	file_internal_server_proto_service_proto_rawDescData = file_internal_server_proto_service_proto_rawDesc
-)
-
-func file_internal_server_proto_service_proto_rawDescGZIP() []byte {
-	file_internal_server_proto_service_proto_rawDescOnce.Do(func() {
-		file_internal_server_proto_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_server_proto_service_proto_rawDescData)
-	})
-	return file_internal_server_proto_service_proto_rawDescData
-}
-
-var file_internal_server_proto_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_server_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_internal_server_proto_service_proto_goTypes = []interface{}{
-	(NodeType)(0),                       // 0: proto.NodeType
-	(NodeClient)(0),                     // 1: proto.NodeClient
-	(Fork)(0),                           // 2: proto.Fork
-	(*DepositListRequest)(nil),          // 3: proto.DepositListRequest
-	(*DepositListResponse)(nil),         // 4: proto.DepositListResponse
-	(*DepositCreateRequest)(nil),        // 5: proto.DepositCreateRequest
-	(*DepositCreateResponse)(nil),       // 6: proto.DepositCreateResponse
-	(*NodeDeployRequest)(nil),           // 7: proto.NodeDeployRequest
-	(*NodeDeployResponse)(nil),          // 8: proto.NodeDeployResponse
-	(*NodeListRequest)(nil),             // 9: proto.NodeListRequest
-	(*NodeListResponse)(nil),            // 10: proto.NodeListResponse
-	(*NodeStatusRequest)(nil),           // 11: proto.NodeStatusRequest
-	(*NodeStatusResponse)(nil),          // 12: proto.NodeStatusResponse
-	(*Node)(nil),                        // 13: proto.Node
-	(*AccountStub)(nil),                 // 14: proto.AccountStub
-	(*TrancheStub)(nil),                 // 15: proto.TrancheStub
-	(*NodeDeployRequest_Beacon)(nil),    // 16: proto.NodeDeployRequest.Beacon
-	(*NodeDeployRequest_Validator)(nil), // 17: proto.NodeDeployRequest.Validator
-	nil,                                 // 18: proto.Node.LabelsEntry
-}
-var file_internal_server_proto_service_proto_depIdxs = []int32{
-	15, // 0: proto.DepositListResponse.tranches:type_name -> proto.TrancheStub
-	15, // 1: proto.DepositCreateResponse.tranche:type_name -> proto.TrancheStub
-	1,  // 2: proto.NodeDeployRequest.nodeClient:type_name -> proto.NodeClient
-	16, // 3: proto.NodeDeployRequest.beacon:type_name -> proto.NodeDeployRequest.Beacon
-	17, // 4: proto.NodeDeployRequest.validator:type_name -> proto.NodeDeployRequest.Validator
-	13, // 5: proto.NodeDeployResponse.nodes:type_name -> proto.Node
-	13, // 6: proto.NodeListResponse.node:type_name -> proto.Node
-	13, // 7: proto.NodeStatusResponse.node:type_name -> proto.Node
-	0,  // 8: proto.Node.type:type_name -> proto.NodeType
-	1,  // 9: proto.Node.client:type_name -> proto.NodeClient
-	18, // 10: proto.Node.labels:type_name -> proto.Node.LabelsEntry
-	14, // 11: proto.TrancheStub.accounts:type_name -> proto.AccountStub
-	5,  // 12: proto.E2EService.DepositCreate:input_type -> proto.DepositCreateRequest
-	3,  // 13: proto.E2EService.DepositList:input_type -> proto.DepositListRequest
-	7,  // 14: proto.E2EService.NodeDeploy:input_type -> proto.NodeDeployRequest
-	9,  // 15: proto.E2EService.NodeList:input_type -> proto.NodeListRequest
-	11, // 16: proto.E2EService.NodeStatus:input_type -> proto.NodeStatusRequest
-	6,  // 17: proto.E2EService.DepositCreate:output_type -> proto.DepositCreateResponse
-	4,  // 18: proto.E2EService.DepositList:output_type -> proto.DepositListResponse
-	8,  // 19: proto.E2EService.NodeDeploy:output_type -> proto.NodeDeployResponse
-	10, // 20: proto.E2EService.NodeList:output_type -> proto.NodeListResponse
-	12, // 21: proto.E2EService.NodeStatus:output_type -> proto.NodeStatusResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_internal_server_proto_service_proto_init() }
-func file_internal_server_proto_service_proto_init() {
-	if File_internal_server_proto_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_server_proto_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositListRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeListRequest.ProtoReflect.Descriptor instead.
+func (*NodeListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{14}
+}
+
+type NodeListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node []*Node `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *NodeListResponse) Reset() {
+	*x = NodeListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeListResponse) ProtoMessage() {}
+
+func (x *NodeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeListResponse.ProtoReflect.Descriptor instead.
+func (*NodeListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NodeListResponse) GetNode() []*Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type NodeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NodeStatusRequest) Reset() {
+	*x = NodeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStatusRequest) ProtoMessage() {}
+
+func (x *NodeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStatusRequest.ProtoReflect.Descriptor instead.
+func (*NodeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NodeStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NodeStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node      *Node            `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Container *ContainerStatus `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	// beacon is only set for beacon nodes
+	Beacon *BeaconStatus `protobuf:"bytes,3,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	// validator is only set for validator nodes
+	Validator *ValidatorStatus `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *NodeStatusResponse) Reset() {
+	*x = NodeStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStatusResponse) ProtoMessage() {}
+
+func (x *NodeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStatusResponse.ProtoReflect.Descriptor instead.
+func (*NodeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NodeStatusResponse) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *NodeStatusResponse) GetContainer() *ContainerStatus {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *NodeStatusResponse) GetBeacon() *BeaconStatus {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
+
+func (x *NodeStatusResponse) GetValidator() *ValidatorStatus {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+// ContainerStatus is the information of the runtime about the node
+type ContainerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// startedAt is the unix time in milliseconds when the node was last started
+	StartedAt int64 `protobuf:"varint,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	// uptime is the number of seconds since the node was last started
+	Uptime uint64 `protobuf:"varint,5,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// mounts maps the paths mounted in the node to the paths in the host
+	Mounts map[string]string `protobuf:"bytes,6,rep,name=mounts,proto3" json:"mounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ports maps the name of the ports used by the node to their address
+	Ports map[string]string `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ContainerStatus) Reset() {
+	*x = ContainerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStatus) ProtoMessage() {}
+
+func (x *ContainerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStatus.ProtoReflect.Descriptor instead.
+func (*ContainerStatus) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ContainerStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerStatus) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ContainerStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ContainerStatus) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ContainerStatus) GetUptime() uint64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *ContainerStatus) GetMounts() map[string]string {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *ContainerStatus) GetPorts() map[string]string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// BeaconStatus is the status of a beacon node queried from its beacon API
+type BeaconStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadSlot       uint64 `protobuf:"varint,1,opt,name=headSlot,proto3" json:"headSlot,omitempty"`
+	FinalizedEpoch uint64 `protobuf:"varint,2,opt,name=finalizedEpoch,proto3" json:"finalizedEpoch,omitempty"`
+	JustifiedEpoch uint64 `protobuf:"varint,3,opt,name=justifiedEpoch,proto3" json:"justifiedEpoch,omitempty"`
+	SyncDistance   uint64 `protobuf:"varint,4,opt,name=syncDistance,proto3" json:"syncDistance,omitempty"`
+	IsSyncing      bool   `protobuf:"varint,5,opt,name=isSyncing,proto3" json:"isSyncing,omitempty"`
+	Peers          uint64 `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+	Version        string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// error is set if the beacon API could not be queried
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BeaconStatus) Reset() {
+	*x = BeaconStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconStatus) ProtoMessage() {}
+
+func (x *BeaconStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconStatus.ProtoReflect.Descriptor instead.
+func (*BeaconStatus) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BeaconStatus) GetHeadSlot() uint64 {
+	if x != nil {
+		return x.HeadSlot
+	}
+	return 0
+}
+
+func (x *BeaconStatus) GetFinalizedEpoch() uint64 {
+	if x != nil {
+		return x.FinalizedEpoch
+	}
+	return 0
+}
+
+func (x *BeaconStatus) GetJustifiedEpoch() uint64 {
+	if x != nil {
+		return x.JustifiedEpoch
+	}
+	return 0
+}
+
+func (x *BeaconStatus) GetSyncDistance() uint64 {
+	if x != nil {
+		return x.SyncDistance
+	}
+	return 0
+}
+
+func (x *BeaconStatus) GetIsSyncing() bool {
+	if x != nil {
+		return x.IsSyncing
+	}
+	return false
+}
+
+func (x *BeaconStatus) GetPeers() uint64 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *BeaconStatus) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BeaconStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidatorStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tranche uint64   `protobuf:"varint,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	PubKeys []string `protobuf:"bytes,2,rep,name=pubKeys,proto3" json:"pubKeys,omitempty"`
+}
+
+func (x *ValidatorStatus) Reset() {
+	*x = ValidatorStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatus) ProtoMessage() {}
+
+func (x *ValidatorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatus.ProtoReflect.Descriptor instead.
+func (*ValidatorStatus) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ValidatorStatus) GetTranche() uint64 {
+	if x != nil {
+		return x.Tranche
+	}
+	return 0
+}
+
+func (x *ValidatorStatus) GetPubKeys() []string {
+	if x != nil {
+		return x.PubKeys
+	}
+	return nil
+}
+
+type NodeStopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NodeStopRequest) Reset() {
+	*x = NodeStopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStopRequest) ProtoMessage() {}
+
+func (x *NodeStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStopRequest.ProtoReflect.Descriptor instead.
+func (*NodeStopRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NodeStopRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NodeStopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeStopResponse) Reset() {
+	*x = NodeStopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStopResponse) ProtoMessage() {}
+
+func (x *NodeStopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStopResponse.ProtoReflect.Descriptor instead.
+func (*NodeStopResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{22}
+}
+
+type NodeStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NodeStartRequest) Reset() {
+	*x = NodeStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStartRequest) ProtoMessage() {}
+
+func (x *NodeStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStartRequest.ProtoReflect.Descriptor instead.
+func (*NodeStartRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NodeStartRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NodeStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeStartResponse) Reset() {
+	*x = NodeStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStartResponse) ProtoMessage() {}
+
+func (x *NodeStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStartResponse.ProtoReflect.Descriptor instead.
+func (*NodeStartResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{24}
+}
+
+type NodeRestartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NodeRestartRequest) Reset() {
+	*x = NodeRestartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRestartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRestartRequest) ProtoMessage() {}
+
+func (x *NodeRestartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRestartRequest.ProtoReflect.Descriptor instead.
+func (*NodeRestartRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NodeRestartRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NodeRestartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeRestartResponse) Reset() {
+	*x = NodeRestartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRestartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRestartResponse) ProtoMessage() {}
+
+func (x *NodeRestartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRestartResponse.ProtoReflect.Descriptor instead.
+func (*NodeRestartResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{26}
+}
+
+type NodeRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NodeRemoveRequest) Reset() {
+	*x = NodeRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRemoveRequest) ProtoMessage() {}
+
+func (x *NodeRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRemoveRequest.ProtoReflect.Descriptor instead.
+func (*NodeRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NodeRemoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NodeRemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeRemoveResponse) Reset() {
+	*x = NodeRemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRemoveResponse) ProtoMessage() {}
+
+func (x *NodeRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRemoveResponse.ProtoReflect.Descriptor instead.
+func (*NodeRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{28}
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{29}
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the sequence number of the event
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// time is the unix time in milliseconds when the event happened
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Event:
+	//	*Event_NodeDeployed_
+	//	*Event_NodeReady_
+	//	*Event_NodeExited_
+	//	*Event_TrancheCreated_
+	//	*Event_DepositSent_
+	//	*Event_DepositMined_
+	//	*Event_GenesisWritten_
+	//	*Event_DepositProgress_
+	Event isEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Event) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Event) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *Event) GetNodeDeployed() *Event_NodeDeployed {
+	if x, ok := x.GetEvent().(*Event_NodeDeployed_); ok {
+		return x.NodeDeployed
+	}
+	return nil
+}
+
+func (x *Event) GetNodeReady() *Event_NodeReady {
+	if x, ok := x.GetEvent().(*Event_NodeReady_); ok {
+		return x.NodeReady
+	}
+	return nil
+}
+
+func (x *Event) GetNodeExited() *Event_NodeExited {
+	if x, ok := x.GetEvent().(*Event_NodeExited_); ok {
+		return x.NodeExited
+	}
+	return nil
+}
+
+func (x *Event) GetTrancheCreated() *Event_TrancheCreated {
+	if x, ok := x.GetEvent().(*Event_TrancheCreated_); ok {
+		return x.TrancheCreated
+	}
+	return nil
+}
+
+func (x *Event) GetDepositSent() *Event_DepositSent {
+	if x, ok := x.GetEvent().(*Event_DepositSent_); ok {
+		return x.DepositSent
+	}
+	return nil
+}
+
+func (x *Event) GetDepositMined() *Event_DepositMined {
+	if x, ok := x.GetEvent().(*Event_DepositMined_); ok {
+		return x.DepositMined
+	}
+	return nil
+}
+
+func (x *Event) GetGenesisWritten() *Event_GenesisWritten {
+	if x, ok := x.GetEvent().(*Event_GenesisWritten_); ok {
+		return x.GenesisWritten
+	}
+	return nil
+}
+
+func (x *Event) GetDepositProgress() *Event_DepositProgress {
+	if x, ok := x.GetEvent().(*Event_DepositProgress_); ok {
+		return x.DepositProgress
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_NodeDeployed_ struct {
+	NodeDeployed *Event_NodeDeployed `protobuf:"bytes,10,opt,name=nodeDeployed,proto3,oneof"`
+}
+
+type Event_NodeReady_ struct {
+	NodeReady *Event_NodeReady `protobuf:"bytes,11,opt,name=nodeReady,proto3,oneof"`
+}
+
+type Event_NodeExited_ struct {
+	NodeExited *Event_NodeExited `protobuf:"bytes,12,opt,name=nodeExited,proto3,oneof"`
+}
+
+type Event_TrancheCreated_ struct {
+	TrancheCreated *Event_TrancheCreated `protobuf:"bytes,13,opt,name=trancheCreated,proto3,oneof"`
+}
+
+type Event_DepositSent_ struct {
+	DepositSent *Event_DepositSent `protobuf:"bytes,14,opt,name=depositSent,proto3,oneof"`
+}
+
+type Event_DepositMined_ struct {
+	DepositMined *Event_DepositMined `protobuf:"bytes,15,opt,name=depositMined,proto3,oneof"`
+}
+
+type Event_GenesisWritten_ struct {
+	GenesisWritten *Event_GenesisWritten `protobuf:"bytes,16,opt,name=genesisWritten,proto3,oneof"`
+}
+
+type Event_DepositProgress_ struct {
+	DepositProgress *Event_DepositProgress `protobuf:"bytes,17,opt,name=depositProgress,proto3,oneof"`
+}
+
+func (*Event_NodeDeployed_) isEvent_Event() {}
+
+func (*Event_NodeReady_) isEvent_Event() {}
+
+func (*Event_NodeExited_) isEvent_Event() {}
+
+func (*Event_TrancheCreated_) isEvent_Event() {}
+
+func (*Event_DepositSent_) isEvent_Event() {}
+
+func (*Event_DepositMined_) isEvent_Event() {}
+
+func (*Event_GenesisWritten_) isEvent_Event() {}
+
+func (*Event_DepositProgress_) isEvent_Event() {}
+
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type   NodeType          `protobuf:"varint,2,opt,name=type,proto3,enum=proto.NodeType" json:"type,omitempty"`
+	Client NodeClient        `protobuf:"varint,3,opt,name=client,proto3,enum=proto.NodeClient" json:"client,omitempty"`
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ip     string            `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	State  NodeState         `protobuf:"varint,6,opt,name=state,proto3,enum=proto.NodeState" json:"state,omitempty"`
+	// restarts is the number of times the node was restarted by its restart policy
+	Restarts uint64    `protobuf:"varint,7,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastExit *NodeExit `protobuf:"bytes,8,opt,name=lastExit,proto3" json:"lastExit,omitempty"`
+	// pair is the name of the execution node of a beacon node
+	// or the beacon node of an execution node
+	Pair string `protobuf:"bytes,9,opt,name=pair,proto3" json:"pair,omitempty"`
+	// executionClient is the client of an execution node
+	ExecutionClient ExecutionClient `protobuf:"varint,10,opt,name=executionClient,proto3,enum=proto.ExecutionClient" json:"executionClient,omitempty"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetType() NodeType {
+	if x != nil {
+		return x.Type
+	}
+	return NodeType_OtherType
+}
+
+func (x *Node) GetClient() NodeClient {
+	if x != nil {
+		return x.Client
+	}
+	return NodeClient_OtherClient
+}
+
+func (x *Node) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Node) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Node) GetState() NodeState {
+	if x != nil {
+		return x.State
+	}
+	return NodeState_Running
+}
+
+func (x *Node) GetRestarts() uint64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *Node) GetLastExit() *NodeExit {
+	if x != nil {
+		return x.LastExit
+	}
+	return nil
+}
+
+func (x *Node) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *Node) GetExecutionClient() ExecutionClient {
+	if x != nil {
+		return x.ExecutionClient
+	}
+	return ExecutionClient_Geth
+}
+
+// NodeExit is the result of a node that stopped running
+type NodeExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode int64  `protobuf:"varint,1,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// time is the unix time in milliseconds when the node exited
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// logs is the path of the file with the last lines of logs of the node
+	Logs string `protobuf:"bytes,4,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *NodeExit) Reset() {
+	*x = NodeExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeExit) ProtoMessage() {}
+
+func (x *NodeExit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeExit.ProtoReflect.Descriptor instead.
+func (*NodeExit) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NodeExit) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *NodeExit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NodeExit) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *NodeExit) GetLogs() string {
+	if x != nil {
+		return x.Logs
+	}
+	return ""
+}
+
+type AccountStub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivKey string `protobuf:"bytes,1,opt,name=privKey,proto3" json:"privKey,omitempty"`
+	PubKey  string `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	// derived is true if the account was derived from the mnemonic
+	// of the server with the derivation index
+	Derived bool   `protobuf:"varint,3,opt,name=derived,proto3" json:"derived,omitempty"`
+	Index   uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *AccountStub) Reset() {
+	*x = AccountStub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStub) ProtoMessage() {}
+
+func (x *AccountStub) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStub.ProtoReflect.Descriptor instead.
+func (*AccountStub) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AccountStub) GetPrivKey() string {
+	if x != nil {
+		return x.PrivKey
+	}
+	return ""
+}
+
+func (x *AccountStub) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *AccountStub) GetDerived() bool {
+	if x != nil {
+		return x.Derived
+	}
+	return false
+}
+
+func (x *AccountStub) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type TrancheStub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    uint64         `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Accounts []*AccountStub `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Name     string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Path     string         `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Deposits []*DepositStub `protobuf:"bytes,5,rep,name=deposits,proto3" json:"deposits,omitempty"`
+}
+
+func (x *TrancheStub) Reset() {
+	*x = TrancheStub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrancheStub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrancheStub) ProtoMessage() {}
+
+func (x *TrancheStub) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrancheStub.ProtoReflect.Descriptor instead.
+func (*TrancheStub) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrancheStub) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TrancheStub) GetAccounts() []*AccountStub {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *TrancheStub) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrancheStub) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrancheStub) GetDeposits() []*DepositStub {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+// DepositStub is a deposit sent for an account of a tranche
+type DepositStub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey string `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	// amount of the deposit in gwei
+	Amount                uint64         `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithdrawalCredentials string         `protobuf:"bytes,3,opt,name=withdrawalCredentials,proto3" json:"withdrawalCredentials,omitempty"`
+	TxnHash               string         `protobuf:"bytes,4,opt,name=txnHash,proto3" json:"txnHash,omitempty"`
+	Invalid               InvalidDeposit `protobuf:"varint,5,opt,name=invalid,proto3,enum=proto.InvalidDeposit" json:"invalid,omitempty"`
+	// rejected is true if the deposit is not expected to activate
+	// a validator with its withdrawal credentials
+	Rejected bool `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *DepositStub) Reset() {
+	*x = DepositStub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositStub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositStub) ProtoMessage() {}
+
+func (x *DepositStub) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositStub.ProtoReflect.Descriptor instead.
+func (*DepositStub) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DepositStub) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *DepositStub) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepositStub) GetWithdrawalCredentials() string {
+	if x != nil {
+		return x.WithdrawalCredentials
+	}
+	return ""
+}
+
+func (x *DepositStub) GetTxnHash() string {
+	if x != nil {
+		return x.TxnHash
+	}
+	return ""
+}
+
+func (x *DepositStub) GetInvalid() InvalidDeposit {
+	if x != nil {
+		return x.Invalid
+	}
+	return InvalidDeposit_NoInvalidDeposit
+}
+
+func (x *DepositStub) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type DepositExportResponse_File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path of the file relative to the output directory
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *DepositExportResponse_File) Reset() {
+	*x = DepositExportResponse_File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositExportResponse_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositExportResponse_File) ProtoMessage() {}
+
+func (x *DepositExportResponse_File) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositExportResponse_File.ProtoReflect.Descriptor instead.
+func (*DepositExportResponse_File) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *DepositExportResponse_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DepositExportResponse_File) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DepositExitResponse_Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PubKey string `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	// status is the status of the validator in the beacon API (i.e. active_exiting)
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ExitEpoch uint64 `protobuf:"varint,4,opt,name=exitEpoch,proto3" json:"exitEpoch,omitempty"`
+}
+
+func (x *DepositExitResponse_Validator) Reset() {
+	*x = DepositExitResponse_Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositExitResponse_Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositExitResponse_Validator) ProtoMessage() {}
+
+func (x *DepositExitResponse_Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositExitResponse_Validator.ProtoReflect.Descriptor instead.
+func (*DepositExitResponse_Validator) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *DepositExitResponse_Validator) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DepositExitResponse_Validator) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *DepositExitResponse_Validator) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DepositExitResponse_Validator) GetExitEpoch() uint64 {
+	if x != nil {
+		return x.ExitEpoch
+	}
+	return 0
+}
+
+type NodeDeployRequest_Execution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *NodeDeployRequest_Execution) Reset() {
+	*x = NodeDeployRequest_Execution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDeployRequest_Execution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDeployRequest_Execution) ProtoMessage() {}
+
+func (x *NodeDeployRequest_Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDeployRequest_Execution.ProtoReflect.Descriptor instead.
+func (*NodeDeployRequest_Execution) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *NodeDeployRequest_Execution) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NodeDeployRequest_Beacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// withExecution deploys a dedicated execution node for each beacon node
+	WithExecution bool `protobuf:"varint,2,opt,name=withExecution,proto3" json:"withExecution,omitempty"`
+}
+
+func (x *NodeDeployRequest_Beacon) Reset() {
+	*x = NodeDeployRequest_Beacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDeployRequest_Beacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDeployRequest_Beacon) ProtoMessage() {}
+
+func (x *NodeDeployRequest_Beacon) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDeployRequest_Beacon.ProtoReflect.Descriptor instead.
+func (*NodeDeployRequest_Beacon) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *NodeDeployRequest_Beacon) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NodeDeployRequest_Beacon) GetWithExecution() bool {
+	if x != nil {
+		return x.WithExecution
+	}
+	return false
+}
+
+type NodeDeployRequest_Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumValidators uint64 `protobuf:"varint,1,opt,name=numValidators,proto3" json:"numValidators,omitempty"`
+	NumTranch     uint64 `protobuf:"varint,2,opt,name=numTranch,proto3" json:"numTranch,omitempty"`
+	WithBeacon    bool   `protobuf:"varint,3,opt,name=withBeacon,proto3" json:"withBeacon,omitempty"`
+	BeaconCount   uint64 `protobuf:"varint,4,opt,name=beaconCount,proto3" json:"beaconCount,omitempty"`
+	// name of the beacon node the validator connects to
+	Beacon string `protobuf:"bytes,5,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	// beaconWithExecution deploys a dedicated execution node
+	// for each beacon node deployed with the validator
+	BeaconWithExecution bool `protobuf:"varint,6,opt,name=beaconWithExecution,proto3" json:"beaconWithExecution,omitempty"`
+	// remoteSigner deploys a web3signer node with the keys
+	// of the validator and signs through it
+	RemoteSigner bool `protobuf:"varint,7,opt,name=remoteSigner,proto3" json:"remoteSigner,omitempty"`
+	// slashingProtection is an EIP-3076 interchange file imported in the
+	// slashing protection database of the validator before it starts
+	SlashingProtection []byte `protobuf:"bytes,8,opt,name=slashingProtection,proto3" json:"slashingProtection,omitempty"`
+}
+
+func (x *NodeDeployRequest_Validator) Reset() {
+	*x = NodeDeployRequest_Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDeployRequest_Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDeployRequest_Validator) ProtoMessage() {}
+
+func (x *NodeDeployRequest_Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDeployRequest_Validator.ProtoReflect.Descriptor instead.
+func (*NodeDeployRequest_Validator) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *NodeDeployRequest_Validator) GetNumValidators() uint64 {
+	if x != nil {
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *NodeDeployRequest_Validator) GetNumTranch() uint64 {
+	if x != nil {
+		return x.NumTranch
+	}
+	return 0
+}
+
+func (x *NodeDeployRequest_Validator) GetWithBeacon() bool {
+	if x != nil {
+		return x.WithBeacon
+	}
+	return false
+}
+
+func (x *NodeDeployRequest_Validator) GetBeaconCount() uint64 {
+	if x != nil {
+		return x.BeaconCount
+	}
+	return 0
+}
+
+func (x *NodeDeployRequest_Validator) GetBeacon() string {
+	if x != nil {
+		return x.Beacon
+	}
+	return ""
+}
+
+func (x *NodeDeployRequest_Validator) GetBeaconWithExecution() bool {
+	if x != nil {
+		return x.BeaconWithExecution
+	}
+	return false
+}
+
+func (x *NodeDeployRequest_Validator) GetRemoteSigner() bool {
+	if x != nil {
+		return x.RemoteSigner
+	}
+	return false
+}
+
+func (x *NodeDeployRequest_Validator) GetSlashingProtection() []byte {
+	if x != nil {
+		return x.SlashingProtection
+	}
+	return nil
+}
+
+// NodeDeployed is emitted once the node starts
+type Event_NodeDeployed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node *Node `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *Event_NodeDeployed) Reset() {
+	*x = Event_NodeDeployed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_NodeDeployed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_NodeDeployed) ProtoMessage() {}
+
+func (x *Event_NodeDeployed) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_NodeDeployed.ProtoReflect.Descriptor instead.
+func (*Event_NodeDeployed) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *Event_NodeDeployed) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// NodeReady is emitted once the readiness check of the node passes
+type Event_NodeReady struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node *Node `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *Event_NodeReady) Reset() {
+	*x = Event_NodeReady{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_NodeReady) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_NodeReady) ProtoMessage() {}
+
+func (x *Event_NodeReady) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_NodeReady.ProtoReflect.Descriptor instead.
+func (*Event_NodeReady) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *Event_NodeReady) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// NodeExited is emitted when the node stops running
+type Event_NodeExited struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node     *Node  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ExitCode int64  `protobuf:"varint,3,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+}
+
+func (x *Event_NodeExited) Reset() {
+	*x = Event_NodeExited{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_NodeExited) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_NodeExited) ProtoMessage() {}
+
+func (x *Event_NodeExited) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_NodeExited.ProtoReflect.Descriptor instead.
+func (*Event_NodeExited) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 2}
+}
+
+func (x *Event_NodeExited) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Event_NodeExited) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Event_NodeExited) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type Event_TrancheCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	NumValidators uint64 `protobuf:"varint,2,opt,name=numValidators,proto3" json:"numValidators,omitempty"`
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *Event_TrancheCreated) Reset() {
+	*x = Event_TrancheCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_TrancheCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_TrancheCreated) ProtoMessage() {}
+
+func (x *Event_TrancheCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_TrancheCreated.ProtoReflect.Descriptor instead.
+func (*Event_TrancheCreated) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 3}
+}
+
+func (x *Event_TrancheCreated) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Event_TrancheCreated) GetNumValidators() uint64 {
+	if x != nil {
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *Event_TrancheCreated) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type Event_DepositSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey  string `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	TxnHash string `protobuf:"bytes,2,opt,name=txnHash,proto3" json:"txnHash,omitempty"`
+}
+
+func (x *Event_DepositSent) Reset() {
+	*x = Event_DepositSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_DepositSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_DepositSent) ProtoMessage() {}
+
+func (x *Event_DepositSent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_DepositSent.ProtoReflect.Descriptor instead.
+func (*Event_DepositSent) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 4}
+}
+
+func (x *Event_DepositSent) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *Event_DepositSent) GetTxnHash() string {
+	if x != nil {
+		return x.TxnHash
+	}
+	return ""
+}
+
+type Event_DepositMined struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey      string `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	TxnHash     string `protobuf:"bytes,2,opt,name=txnHash,proto3" json:"txnHash,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (x *Event_DepositMined) Reset() {
+	*x = Event_DepositMined{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_DepositMined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_DepositMined) ProtoMessage() {}
+
+func (x *Event_DepositMined) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_DepositMined.ProtoReflect.Descriptor instead.
+func (*Event_DepositMined) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 5}
+}
+
+func (x *Event_DepositMined) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *Event_DepositMined) GetTxnHash() string {
+	if x != nil {
+		return x.TxnHash
+	}
+	return ""
+}
+
+func (x *Event_DepositMined) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type Event_GenesisWritten struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	GenesisTime uint64 `protobuf:"varint,2,opt,name=genesisTime,proto3" json:"genesisTime,omitempty"`
+}
+
+func (x *Event_GenesisWritten) Reset() {
+	*x = Event_GenesisWritten{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_GenesisWritten) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_GenesisWritten) ProtoMessage() {}
+
+func (x *Event_GenesisWritten) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_GenesisWritten.ProtoReflect.Descriptor instead.
+func (*Event_GenesisWritten) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 6}
+}
+
+func (x *Event_GenesisWritten) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Event_GenesisWritten) GetGenesisTime() uint64 {
+	if x != nil {
+		return x.GenesisTime
+	}
+	return 0
+}
+
+// DepositProgress is emitted while the deposits of a set of accounts are made
+type Event_DepositProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumAccounts uint64 `protobuf:"varint,1,opt,name=numAccounts,proto3" json:"numAccounts,omitempty"`
+	NumDeposits uint64 `protobuf:"varint,2,opt,name=numDeposits,proto3" json:"numDeposits,omitempty"`
+	// funded is the number of accounts with a mined funding transaction
+	Funded uint64 `protobuf:"varint,3,opt,name=funded,proto3" json:"funded,omitempty"`
+	Sent   uint64 `protobuf:"varint,4,opt,name=sent,proto3" json:"sent,omitempty"`
+	Mined  uint64 `protobuf:"varint,5,opt,name=mined,proto3" json:"mined,omitempty"`
+	// resubmitted is the number of times a transaction was sent again
+	// because it was not mined after some blocks
+	Resubmitted uint64 `protobuf:"varint,6,opt,name=resubmitted,proto3" json:"resubmitted,omitempty"`
+}
+
+func (x *Event_DepositProgress) Reset() {
+	*x = Event_DepositProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_server_proto_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_DepositProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_DepositProgress) ProtoMessage() {}
+
+func (x *Event_DepositProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_server_proto_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_DepositProgress.ProtoReflect.Descriptor instead.
+func (*Event_DepositProgress) Descriptor() ([]byte, []int) {
+	return file_internal_server_proto_service_proto_rawDescGZIP(), []int{30, 7}
+}
+
+func (x *Event_DepositProgress) GetNumAccounts() uint64 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+func (x *Event_DepositProgress) GetNumDeposits() uint64 {
+	if x != nil {
+		return x.NumDeposits
+	}
+	return 0
+}
+
+func (x *Event_DepositProgress) GetFunded() uint64 {
+	if x != nil {
+		return x.Funded
+	}
+	return 0
+}
+
+func (x *Event_DepositProgress) GetSent() uint64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *Event_DepositProgress) GetMined() uint64 {
+	if x != nil {
+		return x.Mined
+	}
+	return 0
+}
+
+func (x *Event_DepositProgress) GetResubmitted() uint64 {
+	if x != nil {
+		return x.Resubmitted
+	}
+	return 0
+}
+
+var File_internal_server_proto_service_proto protoreflect.FileDescriptor
+
+var file_internal_server_proto_service_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x53, 0x74, 0x75, 0x62, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x14, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x34, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2a, 0x0a,
+	0x14, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x75, 0x62, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x22, 0x60, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x1a, 0x6f, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0xb7, 0x02, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x55, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x55, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x53, 0x74, 0x75, 0x62, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x22, 0x88, 0x07, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x42,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x42, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x44, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x69, 0x74,
+	0x68, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0xaf, 0x02, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x37, 0x0a,
+	0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x6e,
+	0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x6b, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x11, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0xed, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x25,
+	0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x13, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x15, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x14, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x90, 0x0a, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x45,
+	0x78, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78,
+	0x69, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x69, 0x74,
+	0x65, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x48, 0x00, 0x52,
+	0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12,
+	0x48, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x2f, 0x0a, 0x0c, 0x4e, 0x6f, 0x64,
+	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x2c, 0x0a, 0x09, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x1a, 0x5f, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65,
+	0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x60, 0x0a, 0x0e, 0x54, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x3f, 0x0a, 0x0b, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x62, 0x0a, 0x0c,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x1a, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xb9, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x75, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d, 0x69, 0x6e,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x03,
+	0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78,
+	0x69, 0x74, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x78, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a,
+	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x22, 0x6f, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74,
+	0x75, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x75, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x75, 0x62,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x75, 0x62, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74,
+	0x75, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x2f, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2a,
+	0x48, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x0d, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x03, 0x2a, 0x76, 0x0a, 0x0e, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x4e,
+	0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x10, 0x03, 0x12, 0x11,
+	0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10,
+	0x04, 0x2a, 0x45, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x6c,
+	0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x03, 0x2a, 0x35, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x09, 0x0a, 0x05,
+	0x4e, 0x65, 0x76, 0x65, 0x72, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73,
+	0x10, 0x02, 0x2a, 0x5d, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d,
+	0x0a, 0x09, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74,
+	0x6e, 0x6f, 0x64, 0x65, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x10,
+	0x05, 0x2a, 0x5c, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x72, 0x79, 0x73, 0x6d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54,
+	0x65, 0x6b, 0x75, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x69, 0x6d, 0x62, 0x75, 0x73, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x6f, 0x64, 0x65, 0x73, 0x74, 0x61, 0x72, 0x10, 0x05, 0x2a,
+	0x41, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x65, 0x74, 0x68, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x42, 0x65, 0x73, 0x75, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x72, 0x69, 0x67, 0x6f, 0x6e,
+	0x10, 0x03, 0x2a, 0x29, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x30, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x6c, 0x74, 0x61, 0x69, 0x72,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x10, 0x02, 0x32, 0xd2, 0x07,
+	0x0a, 0x0a, 0x45, 0x32, 0x45, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x45, 0x78, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x41,
+	0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x42, 0x18, 0x5a, 0x16, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_server_proto_service_proto_rawDescOnce sync.Once
+	file_internal_server_proto_service_proto_rawDescData = file_internal_server_proto_service_proto_rawDesc
+)
+
+func file_internal_server_proto_service_proto_rawDescGZIP() []byte {
+	file_internal_server_proto_service_proto_rawDescOnce.Do(func() {
+		file_internal_server_proto_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_server_proto_service_proto_rawDescData)
+	})
+	return file_internal_server_proto_service_proto_rawDescData
+}
+
+var file_internal_server_proto_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_internal_server_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_internal_server_proto_service_proto_goTypes = []interface{}{
+	(ExportFormat)(0),                     // 0: proto.ExportFormat
+	(InvalidDeposit)(0),                   // 1: proto.InvalidDeposit
+	(WithdrawalCredentials)(0),            // 2: proto.WithdrawalCredentials
+	(NodeState)(0),                        // 3: proto.NodeState
+	(RestartPolicy)(0),                    // 4: proto.RestartPolicy
+	(NodeType)(0),                         // 5: proto.NodeType
+	(NodeClient)(0),                       // 6: proto.NodeClient
+	(ExecutionClient)(0),                  // 7: proto.ExecutionClient
+	(Fork)(0),                             // 8: proto.Fork
+	(*DepositListRequest)(nil),            // 9: proto.DepositListRequest
+	(*DepositListResponse)(nil),           // 10: proto.DepositListResponse
+	(*DepositExportRequest)(nil),          // 11: proto.DepositExportRequest
+	(*DepositExportResponse)(nil),         // 12: proto.DepositExportResponse
+	(*DepositImportRequest)(nil),          // 13: proto.DepositImportRequest
+	(*DepositImportResponse)(nil),         // 14: proto.DepositImportResponse
+	(*DepositExitRequest)(nil),            // 15: proto.DepositExitRequest
+	(*DepositExitResponse)(nil),           // 16: proto.DepositExitResponse
+	(*DepositCreateRequest)(nil),          // 17: proto.DepositCreateRequest
+	(*DepositCreateResponse)(nil),         // 18: proto.DepositCreateResponse
+	(*NodeDeployRequest)(nil),             // 19: proto.NodeDeployRequest
+	(*NodeDeployResponse)(nil),            // 20: proto.NodeDeployResponse
+	(*ValidatorMigrateRequest)(nil),       // 21: proto.ValidatorMigrateRequest
+	(*ValidatorMigrateResponse)(nil),      // 22: proto.ValidatorMigrateResponse
+	(*NodeListRequest)(nil),               // 23: proto.NodeListRequest
+	(*NodeListResponse)(nil),              // 24: proto.NodeListResponse
+	(*NodeStatusRequest)(nil),             // 25: proto.NodeStatusRequest
+	(*NodeStatusResponse)(nil),            // 26: proto.NodeStatusResponse
+	(*ContainerStatus)(nil),               // 27: proto.ContainerStatus
+	(*BeaconStatus)(nil),                  // 28: proto.BeaconStatus
+	(*ValidatorStatus)(nil),               // 29: proto.ValidatorStatus
+	(*NodeStopRequest)(nil),               // 30: proto.NodeStopRequest
+	(*NodeStopResponse)(nil),              // 31: proto.NodeStopResponse
+	(*NodeStartRequest)(nil),              // 32: proto.NodeStartRequest
+	(*NodeStartResponse)(nil),             // 33: proto.NodeStartResponse
+	(*NodeRestartRequest)(nil),            // 34: proto.NodeRestartRequest
+	(*NodeRestartResponse)(nil),           // 35: proto.NodeRestartResponse
+	(*NodeRemoveRequest)(nil),             // 36: proto.NodeRemoveRequest
+	(*NodeRemoveResponse)(nil),            // 37: proto.NodeRemoveResponse
+	(*SubscribeRequest)(nil),              // 38: proto.SubscribeRequest
+	(*Event)(nil),                         // 39: proto.Event
+	(*Node)(nil),                          // 40: proto.Node
+	(*NodeExit)(nil),                      // 41: proto.NodeExit
+	(*AccountStub)(nil),                   // 42: proto.AccountStub
+	(*TrancheStub)(nil),                   // 43: proto.TrancheStub
+	(*DepositStub)(nil),                   // 44: proto.DepositStub
+	(*DepositExportResponse_File)(nil),    // 45: proto.DepositExportResponse.File
+	(*DepositExitResponse_Validator)(nil), // 46: proto.DepositExitResponse.Validator
+	(*NodeDeployRequest_Execution)(nil),   // 47: proto.NodeDeployRequest.Execution
+	(*NodeDeployRequest_Beacon)(nil),      // 48: proto.NodeDeployRequest.Beacon
+	(*NodeDeployRequest_Validator)(nil),   // 49: proto.NodeDeployRequest.Validator
+	nil,                                   // 50: proto.ContainerStatus.MountsEntry
+	nil,                                   // 51: proto.ContainerStatus.PortsEntry
+	(*Event_NodeDeployed)(nil),            // 52: proto.Event.NodeDeployed
+	(*Event_NodeReady)(nil),               // 53: proto.Event.NodeReady
+	(*Event_NodeExited)(nil),              // 54: proto.Event.NodeExited
+	(*Event_TrancheCreated)(nil),          // 55: proto.Event.TrancheCreated
+	(*Event_DepositSent)(nil),             // 56: proto.Event.DepositSent
+	(*Event_DepositMined)(nil),            // 57: proto.Event.DepositMined
+	(*Event_GenesisWritten)(nil),          // 58: proto.Event.GenesisWritten
+	(*Event_DepositProgress)(nil),         // 59: proto.Event.DepositProgress
+	nil,                                   // 60: proto.Node.LabelsEntry
+}
+var file_internal_server_proto_service_proto_depIdxs = []int32{
+	43, // 0: proto.DepositListResponse.tranches:type_name -> proto.TrancheStub
+	0,  // 1: proto.DepositExportRequest.format:type_name -> proto.ExportFormat
+	45, // 2: proto.DepositExportResponse.files:type_name -> proto.DepositExportResponse.File
+	43, // 3: proto.DepositImportResponse.tranche:type_name -> proto.TrancheStub
+	46, // 4: proto.DepositExitResponse.validators:type_name -> proto.DepositExitResponse.Validator
+	2,  // 5: proto.DepositCreateRequest.withdrawalCredentials:type_name -> proto.WithdrawalCredentials
+	1,  // 6: proto.DepositCreateRequest.invalid:type_name -> proto.InvalidDeposit
+	43, // 7: proto.DepositCreateResponse.tranche:type_name -> proto.TrancheStub
+	6,  // 8: proto.NodeDeployRequest.nodeClient:type_name -> proto.NodeClient
+	4,  // 9: proto.NodeDeployRequest.restartPolicy:type_name -> proto.RestartPolicy
+	7,  // 10: proto.NodeDeployRequest.executionClient:type_name -> proto.ExecutionClient
+	48, // 11: proto.NodeDeployRequest.beacon:type_name -> proto.NodeDeployRequest.Beacon
+	49, // 12: proto.NodeDeployRequest.validator:type_name -> proto.NodeDeployRequest.Validator
+	47, // 13: proto.NodeDeployRequest.execution:type_name -> proto.NodeDeployRequest.Execution
+	40, // 14: proto.NodeDeployResponse.nodes:type_name -> proto.Node
+	6,  // 15: proto.ValidatorMigrateRequest.nodeClient:type_name -> proto.NodeClient
+	4,  // 16: proto.ValidatorMigrateRequest.restartPolicy:type_name -> proto.RestartPolicy
+	40, // 17: proto.ValidatorMigrateResponse.node:type_name -> proto.Node
+	40, // 18: proto.NodeListResponse.node:type_name -> proto.Node
+	40, // 19: proto.NodeStatusResponse.node:type_name -> proto.Node
+	27, // 20: proto.NodeStatusResponse.container:type_name -> proto.ContainerStatus
+	28, // 21: proto.NodeStatusResponse.beacon:type_name -> proto.BeaconStatus
+	29, // 22: proto.NodeStatusResponse.validator:type_name -> proto.ValidatorStatus
+	50, // 23: proto.ContainerStatus.mounts:type_name -> proto.ContainerStatus.MountsEntry
+	51, // 24: proto.ContainerStatus.ports:type_name -> proto.ContainerStatus.PortsEntry
+	52, // 25: proto.Event.nodeDeployed:type_name -> proto.Event.NodeDeployed
+	53, // 26: proto.Event.nodeReady:type_name -> proto.Event.NodeReady
+	54, // 27: proto.Event.nodeExited:type_name -> proto.Event.NodeExited
+	55, // 28: proto.Event.trancheCreated:type_name -> proto.Event.TrancheCreated
+	56, // 29: proto.Event.depositSent:type_name -> proto.Event.DepositSent
+	57, // 30: proto.Event.depositMined:type_name -> proto.Event.DepositMined
+	58, // 31: proto.Event.genesisWritten:type_name -> proto.Event.GenesisWritten
+	59, // 32: proto.Event.depositProgress:type_name -> proto.Event.DepositProgress
+	5,  // 33: proto.Node.type:type_name -> proto.NodeType
+	6,  // 34: proto.Node.client:type_name -> proto.NodeClient
+	60, // 35: proto.Node.labels:type_name -> proto.Node.LabelsEntry
+	3,  // 36: proto.Node.state:type_name -> proto.NodeState
+	41, // 37: proto.Node.lastExit:type_name -> proto.NodeExit
+	7,  // 38: proto.Node.executionClient:type_name -> proto.ExecutionClient
+	42, // 39: proto.TrancheStub.accounts:type_name -> proto.AccountStub
+	44, // 40: proto.TrancheStub.deposits:type_name -> proto.DepositStub
+	1,  // 41: proto.DepositStub.invalid:type_name -> proto.InvalidDeposit
+	40, // 42: proto.Event.NodeDeployed.node:type_name -> proto.Node
+	40, // 43: proto.Event.NodeReady.node:type_name -> proto.Node
+	40, // 44: proto.Event.NodeExited.node:type_name -> proto.Node
+	17, // 45: proto.E2EService.DepositCreate:input_type -> proto.DepositCreateRequest
+	9,  // 46: proto.E2EService.DepositList:input_type -> proto.DepositListRequest
+	11, // 47: proto.E2EService.DepositExport:input_type -> proto.DepositExportRequest
+	13, // 48: proto.E2EService.DepositImport:input_type -> proto.DepositImportRequest
+	15, // 49: proto.E2EService.DepositExit:input_type -> proto.DepositExitRequest
+	19, // 50: proto.E2EService.NodeDeploy:input_type -> proto.NodeDeployRequest
+	23, // 51: proto.E2EService.NodeList:input_type -> proto.NodeListRequest
+	25, // 52: proto.E2EService.NodeStatus:input_type -> proto.NodeStatusRequest
+	30, // 53: proto.E2EService.NodeStop:input_type -> proto.NodeStopRequest
+	32, // 54: proto.E2EService.NodeStart:input_type -> proto.NodeStartRequest
+	34, // 55: proto.E2EService.NodeRestart:input_type -> proto.NodeRestartRequest
+	36, // 56: proto.E2EService.NodeRemove:input_type -> proto.NodeRemoveRequest
+	21, // 57: proto.E2EService.ValidatorMigrate:input_type -> proto.ValidatorMigrateRequest
+	38, // 58: proto.E2EService.Subscribe:input_type -> proto.SubscribeRequest
+	18, // 59: proto.E2EService.DepositCreate:output_type -> proto.DepositCreateResponse
+	10, // 60: proto.E2EService.DepositList:output_type -> proto.DepositListResponse
+	12, // 61: proto.E2EService.DepositExport:output_type -> proto.DepositExportResponse
+	14, // 62: proto.E2EService.DepositImport:output_type -> proto.DepositImportResponse
+	16, // 63: proto.E2EService.DepositExit:output_type -> proto.DepositExitResponse
+	20, // 64: proto.E2EService.NodeDeploy:output_type -> proto.NodeDeployResponse
+	24, // 65: proto.E2EService.NodeList:output_type -> proto.NodeListResponse
+	26, // 66: proto.E2EService.NodeStatus:output_type -> proto.NodeStatusResponse
+	31, // 67: proto.E2EService.NodeStop:output_type -> proto.NodeStopResponse
+	33, // 68: proto.E2EService.NodeStart:output_type -> proto.NodeStartResponse
+	35, // 69: proto.E2EService.NodeRestart:output_type -> proto.NodeRestartResponse
+	37, // 70: proto.E2EService.NodeRemove:output_type -> proto.NodeRemoveResponse
+	22, // 71: proto.E2EService.ValidatorMigrate:output_type -> proto.ValidatorMigrateResponse
+	39, // 72: proto.E2EService.Subscribe:output_type -> proto.Event
+	59, // [59:73] is the sub-list for method output_type
+	45, // [45:59] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_internal_server_proto_service_proto_init() }
+func file_internal_server_proto_service_proto_init() {
+	if File_internal_server_proto_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_server_proto_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeployRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeployResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorMigrateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorMigrateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1228,8 +4609,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositListResponse); i {
+		file_internal_server_proto_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,8 +4621,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositCreateRequest); i {
+		file_internal_server_proto_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1252,8 +4633,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositCreateResponse); i {
+		file_internal_server_proto_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +4645,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeployRequest); i {
+		file_internal_server_proto_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +4657,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeployResponse); i {
+		file_internal_server_proto_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,8 +4669,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeListRequest); i {
+		file_internal_server_proto_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRestartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1300,8 +4681,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeListResponse); i {
+		file_internal_server_proto_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRestartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1312,8 +4693,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeStatusRequest); i {
+		file_internal_server_proto_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,8 +4705,8 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeStatusResponse); i {
+		file_internal_server_proto_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,7 +4717,31 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_server_proto_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
@@ -1348,7 +4753,19 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_server_proto_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccountStub); i {
 			case 0:
 				return &v.state
@@ -1360,7 +4777,7 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_server_proto_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrancheStub); i {
 			case 0:
 				return &v.state
@@ -1372,7 +4789,55 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_server_proto_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositStub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExportResponse_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositExitResponse_Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeployRequest_Execution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeDeployRequest_Beacon); i {
 			case 0:
 				return &v.state
@@ -1384,7 +4849,7 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_server_proto_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_server_proto_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeDeployRequest_Validator); i {
 			case 0:
 				return &v.state
@@ -1396,18 +4861,125 @@ func file_internal_server_proto_service_proto_init() {
 				return nil
 			}
 		}
+		file_internal_server_proto_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_NodeDeployed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_NodeReady); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_NodeExited); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_TrancheCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_DepositSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_DepositMined); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_GenesisWritten); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_server_proto_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event_DepositProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_internal_server_proto_service_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_internal_server_proto_service_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*NodeDeployRequest_Beacon_)(nil),
 		(*NodeDeployRequest_Validator_)(nil),
+		(*NodeDeployRequest_Execution_)(nil),
+	}
+	file_internal_server_proto_service_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*Event_NodeDeployed_)(nil),
+		(*Event_NodeReady_)(nil),
+		(*Event_NodeExited_)(nil),
+		(*Event_TrancheCreated_)(nil),
+		(*Event_DepositSent_)(nil),
+		(*Event_DepositMined_)(nil),
+		(*Event_GenesisWritten_)(nil),
+		(*Event_DepositProgress_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_server_proto_service_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   16,
+			NumEnums:      9,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
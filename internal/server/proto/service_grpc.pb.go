@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.0
+// source: internal/server/proto/service.proto
 
 package proto
 
@@ -20,9 +24,18 @@ const _ = grpc.SupportPackageIsVersion7
 type E2EServiceClient interface {
 	DepositCreate(ctx context.Context, in *DepositCreateRequest, opts ...grpc.CallOption) (*DepositCreateResponse, error)
 	DepositList(ctx context.Context, in *DepositListRequest, opts ...grpc.CallOption) (*DepositListResponse, error)
+	DepositExport(ctx context.Context, in *DepositExportRequest, opts ...grpc.CallOption) (*DepositExportResponse, error)
+	DepositImport(ctx context.Context, in *DepositImportRequest, opts ...grpc.CallOption) (*DepositImportResponse, error)
+	DepositExit(ctx context.Context, in *DepositExitRequest, opts ...grpc.CallOption) (E2EService_DepositExitClient, error)
 	NodeDeploy(ctx context.Context, in *NodeDeployRequest, opts ...grpc.CallOption) (*NodeDeployResponse, error)
 	NodeList(ctx context.Context, in *NodeListRequest, opts ...grpc.CallOption) (*NodeListResponse, error)
 	NodeStatus(ctx context.Context, in *NodeStatusRequest, opts ...grpc.CallOption) (*NodeStatusResponse, error)
+	NodeStop(ctx context.Context, in *NodeStopRequest, opts ...grpc.CallOption) (*NodeStopResponse, error)
+	NodeStart(ctx context.Context, in *NodeStartRequest, opts ...grpc.CallOption) (*NodeStartResponse, error)
+	NodeRestart(ctx context.Context, in *NodeRestartRequest, opts ...grpc.CallOption) (*NodeRestartResponse, error)
+	NodeRemove(ctx context.Context, in *NodeRemoveRequest, opts ...grpc.CallOption) (*NodeRemoveResponse, error)
+	ValidatorMigrate(ctx context.Context, in *ValidatorMigrateRequest, opts ...grpc.CallOption) (*ValidatorMigrateResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (E2EService_SubscribeClient, error)
 }
 
 type e2EServiceClient struct {
@@ -51,6 +64,56 @@ func (c *e2EServiceClient) DepositList(ctx context.Context, in *DepositListReque
 	return out, nil
 }
 
+func (c *e2EServiceClient) DepositExport(ctx context.Context, in *DepositExportRequest, opts ...grpc.CallOption) (*DepositExportResponse, error) {
+	out := new(DepositExportResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/DepositExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) DepositImport(ctx context.Context, in *DepositImportRequest, opts ...grpc.CallOption) (*DepositImportResponse, error) {
+	out := new(DepositImportResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/DepositImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) DepositExit(ctx context.Context, in *DepositExitRequest, opts ...grpc.CallOption) (E2EService_DepositExitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &E2EService_ServiceDesc.Streams[0], "/proto.E2EService/DepositExit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &e2EServiceDepositExitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type E2EService_DepositExitClient interface {
+	Recv() (*DepositExitResponse, error)
+	grpc.ClientStream
+}
+
+type e2EServiceDepositExitClient struct {
+	grpc.ClientStream
+}
+
+func (x *e2EServiceDepositExitClient) Recv() (*DepositExitResponse, error) {
+	m := new(DepositExitResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *e2EServiceClient) NodeDeploy(ctx context.Context, in *NodeDeployRequest, opts ...grpc.CallOption) (*NodeDeployResponse, error) {
 	out := new(NodeDeployResponse)
 	err := c.cc.Invoke(ctx, "/proto.E2EService/NodeDeploy", in, out, opts...)
@@ -78,15 +141,101 @@ func (c *e2EServiceClient) NodeStatus(ctx context.Context, in *NodeStatusRequest
 	return out, nil
 }
 
+func (c *e2EServiceClient) NodeStop(ctx context.Context, in *NodeStopRequest, opts ...grpc.CallOption) (*NodeStopResponse, error) {
+	out := new(NodeStopResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/NodeStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) NodeStart(ctx context.Context, in *NodeStartRequest, opts ...grpc.CallOption) (*NodeStartResponse, error) {
+	out := new(NodeStartResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/NodeStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) NodeRestart(ctx context.Context, in *NodeRestartRequest, opts ...grpc.CallOption) (*NodeRestartResponse, error) {
+	out := new(NodeRestartResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/NodeRestart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) NodeRemove(ctx context.Context, in *NodeRemoveRequest, opts ...grpc.CallOption) (*NodeRemoveResponse, error) {
+	out := new(NodeRemoveResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/NodeRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) ValidatorMigrate(ctx context.Context, in *ValidatorMigrateRequest, opts ...grpc.CallOption) (*ValidatorMigrateResponse, error) {
+	out := new(ValidatorMigrateResponse)
+	err := c.cc.Invoke(ctx, "/proto.E2EService/ValidatorMigrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *e2EServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (E2EService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &E2EService_ServiceDesc.Streams[1], "/proto.E2EService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &e2EServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type E2EService_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type e2EServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *e2EServiceSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // E2EServiceServer is the server API for E2EService service.
 // All implementations must embed UnimplementedE2EServiceServer
 // for forward compatibility
 type E2EServiceServer interface {
 	DepositCreate(context.Context, *DepositCreateRequest) (*DepositCreateResponse, error)
 	DepositList(context.Context, *DepositListRequest) (*DepositListResponse, error)
+	DepositExport(context.Context, *DepositExportRequest) (*DepositExportResponse, error)
+	DepositImport(context.Context, *DepositImportRequest) (*DepositImportResponse, error)
+	DepositExit(*DepositExitRequest, E2EService_DepositExitServer) error
 	NodeDeploy(context.Context, *NodeDeployRequest) (*NodeDeployResponse, error)
 	NodeList(context.Context, *NodeListRequest) (*NodeListResponse, error)
 	NodeStatus(context.Context, *NodeStatusRequest) (*NodeStatusResponse, error)
+	NodeStop(context.Context, *NodeStopRequest) (*NodeStopResponse, error)
+	NodeStart(context.Context, *NodeStartRequest) (*NodeStartResponse, error)
+	NodeRestart(context.Context, *NodeRestartRequest) (*NodeRestartResponse, error)
+	NodeRemove(context.Context, *NodeRemoveRequest) (*NodeRemoveResponse, error)
+	ValidatorMigrate(context.Context, *ValidatorMigrateRequest) (*ValidatorMigrateResponse, error)
+	Subscribe(*SubscribeRequest, E2EService_SubscribeServer) error
 	mustEmbedUnimplementedE2EServiceServer()
 }
 
@@ -100,6 +249,15 @@ func (UnimplementedE2EServiceServer) DepositCreate(context.Context, *DepositCrea
 func (UnimplementedE2EServiceServer) DepositList(context.Context, *DepositListRequest) (*DepositListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositList not implemented")
 }
+func (UnimplementedE2EServiceServer) DepositExport(context.Context, *DepositExportRequest) (*DepositExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositExport not implemented")
+}
+func (UnimplementedE2EServiceServer) DepositImport(context.Context, *DepositImportRequest) (*DepositImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositImport not implemented")
+}
+func (UnimplementedE2EServiceServer) DepositExit(*DepositExitRequest, E2EService_DepositExitServer) error {
+	return status.Errorf(codes.Unimplemented, "method DepositExit not implemented")
+}
 func (UnimplementedE2EServiceServer) NodeDeploy(context.Context, *NodeDeployRequest) (*NodeDeployResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeDeploy not implemented")
 }
@@ -109,6 +267,24 @@ func (UnimplementedE2EServiceServer) NodeList(context.Context, *NodeListRequest)
 func (UnimplementedE2EServiceServer) NodeStatus(context.Context, *NodeStatusRequest) (*NodeStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeStatus not implemented")
 }
+func (UnimplementedE2EServiceServer) NodeStop(context.Context, *NodeStopRequest) (*NodeStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeStop not implemented")
+}
+func (UnimplementedE2EServiceServer) NodeStart(context.Context, *NodeStartRequest) (*NodeStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeStart not implemented")
+}
+func (UnimplementedE2EServiceServer) NodeRestart(context.Context, *NodeRestartRequest) (*NodeRestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeRestart not implemented")
+}
+func (UnimplementedE2EServiceServer) NodeRemove(context.Context, *NodeRemoveRequest) (*NodeRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeRemove not implemented")
+}
+func (UnimplementedE2EServiceServer) ValidatorMigrate(context.Context, *ValidatorMigrateRequest) (*ValidatorMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMigrate not implemented")
+}
+func (UnimplementedE2EServiceServer) Subscribe(*SubscribeRequest, E2EService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
 func (UnimplementedE2EServiceServer) mustEmbedUnimplementedE2EServiceServer() {}
 
 // UnsafeE2EServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +334,63 @@ func _E2EService_DepositList_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _E2EService_DepositExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).DepositExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/DepositExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).DepositExport(ctx, req.(*DepositExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_DepositImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).DepositImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/DepositImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).DepositImport(ctx, req.(*DepositImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_DepositExit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DepositExitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(E2EServiceServer).DepositExit(m, &e2EServiceDepositExitServer{stream})
+}
+
+type E2EService_DepositExitServer interface {
+	Send(*DepositExitResponse) error
+	grpc.ServerStream
+}
+
+type e2EServiceDepositExitServer struct {
+	grpc.ServerStream
+}
+
+func (x *e2EServiceDepositExitServer) Send(m *DepositExitResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _E2EService_NodeDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeDeployRequest)
 	if err := dec(in); err != nil {
@@ -212,6 +445,117 @@ func _E2EService_NodeStatus_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _E2EService_NodeStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).NodeStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/NodeStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).NodeStop(ctx, req.(*NodeStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_NodeStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).NodeStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/NodeStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).NodeStart(ctx, req.(*NodeStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_NodeRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).NodeRestart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/NodeRestart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).NodeRestart(ctx, req.(*NodeRestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_NodeRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).NodeRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/NodeRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).NodeRemove(ctx, req.(*NodeRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_ValidatorMigrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(E2EServiceServer).ValidatorMigrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.E2EService/ValidatorMigrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(E2EServiceServer).ValidatorMigrate(ctx, req.(*ValidatorMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _E2EService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(E2EServiceServer).Subscribe(m, &e2EServiceSubscribeServer{stream})
+}
+
+type E2EService_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type e2EServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *e2EServiceSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // E2EService_ServiceDesc is the grpc.ServiceDesc for E2EService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +571,14 @@ var E2EService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositList",
 			Handler:    _E2EService_DepositList_Handler,
 		},
+		{
+			MethodName: "DepositExport",
+			Handler:    _E2EService_DepositExport_Handler,
+		},
+		{
+			MethodName: "DepositImport",
+			Handler:    _E2EService_DepositImport_Handler,
+		},
 		{
 			MethodName: "NodeDeploy",
 			Handler:    _E2EService_NodeDeploy_Handler,
@@ -239,7 +591,38 @@ var E2EService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NodeStatus",
 			Handler:    _E2EService_NodeStatus_Handler,
 		},
+		{
+			MethodName: "NodeStop",
+			Handler:    _E2EService_NodeStop_Handler,
+		},
+		{
+			MethodName: "NodeStart",
+			Handler:    _E2EService_NodeStart_Handler,
+		},
+		{
+			MethodName: "NodeRestart",
+			Handler:    _E2EService_NodeRestart_Handler,
+		},
+		{
+			MethodName: "NodeRemove",
+			Handler:    _E2EService_NodeRemove_Handler,
+		},
+		{
+			MethodName: "ValidatorMigrate",
+			Handler:    _E2EService_ValidatorMigrate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DepositExit",
+			Handler:       _E2EService_DepositExit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _E2EService_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/server/proto/service.proto",
 }
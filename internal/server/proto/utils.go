@@ -24,6 +24,54 @@ func StringToNodeClient(str string) (NodeClient, bool) {
 	return NodeClient(found), true
 }
 
+func StringToExecutionClient(str string) (ExecutionClient, bool) {
+	found, ok := ExecutionClient_value[strings.Title(str)]
+	if !ok {
+		return 0, false
+	}
+	return ExecutionClient(found), true
+}
+
+// StringToRestartPolicy converts a restart policy in the format
+// of the cli (i.e. on-failure) to a RestartPolicy
+func StringToRestartPolicy(str string) (RestartPolicy, bool) {
+	found, ok := RestartPolicy_value[strings.ReplaceAll(strings.Title(str), "-", "")]
+	if !ok {
+		return 0, false
+	}
+	return RestartPolicy(found), true
+}
+
+// StringToExportFormat converts an export format in the format
+// of the cli (i.e. deposit-data) to an ExportFormat
+func StringToExportFormat(str string) (ExportFormat, bool) {
+	found, ok := ExportFormat_value[strings.ReplaceAll(strings.Title(str), "-", "")]
+	if !ok {
+		return 0, false
+	}
+	return ExportFormat(found), true
+}
+
+// StringToWithdrawalCredentials converts a withdrawal credentials type
+// in the format of the cli (i.e. execution) to a WithdrawalCredentials
+func StringToWithdrawalCredentials(str string) (WithdrawalCredentials, bool) {
+	found, ok := WithdrawalCredentials_value[strings.Title(str)+"Credentials"]
+	if !ok {
+		return 0, false
+	}
+	return WithdrawalCredentials(found), true
+}
+
+// StringToInvalidDeposit converts an invalid deposit kind in the
+// format of the cli (i.e. signature) to an InvalidDeposit
+func StringToInvalidDeposit(str string) (InvalidDeposit, bool) {
+	found, ok := InvalidDeposit_value["Invalid"+strings.Title(str)]
+	if !ok {
+		return 0, false
+	}
+	return InvalidDeposit(found), true
+}
+
 type NodePort string
 
 const (
@@ -33,7 +81,7 @@ const (
 	// NodePortEth1P2P is the p2p port for an eth1 node.
 	NodePortEth1P2P = "eth1.p2p"
 
-	// NodePortEth1AuthRPC is the p2p port for the eth1 authrpc endpoint.
+	// NodePortEth1AuthRPC is the authenticated engine RPC port for an eth1 node.
 	NodePortEth1AuthRPC = "eth1.authrpc"
 
 	// NodePortP2P is the p2p port for an eth2 node.
@@ -47,6 +95,9 @@ const (
 
 	// NodePortBootnode is the port for the bootnode
 	NodePortBootnode = "eth.bootnode"
+
+	// NodePortWeb3Signer is the http port of the web3signer remote signer
+	NodePortWeb3Signer = "web3signer.http"
 )
 
 func (n NodePort) IsTCP() bool {
@@ -54,14 +105,39 @@ func (n NodePort) IsTCP() bool {
 }
 
 const (
-	NodeClientLabel = "NodeClient"
-	NodeTypeLabel   = "NodeType"
+	NodeClientLabel      = "NodeClient"
+	NodeTypeLabel        = "NodeType"
+	ExecutionClientLabel = "ExecutionClient"
+
+	// NodePairLabel is the name of the node paired with a beacon or an execution node,
+	// or with a validator and its remote signer
+	NodePairLabel = "NodePair"
 )
 
 type ValidatorConfig struct {
 	Spec     []byte
 	Accounts []*Account
 	Beacon   spec.Node
+
+	// Preset is the preset of the network (mainnet or minimal)
+	Preset string
+
+	// FeeRecipient is the address that receives the fees of the
+	// execution payloads proposed after the merge
+	FeeRecipient string
+
+	// RemoteSigner is the address of the web3signer that holds the keys
+	// of the accounts. If set, the validator signs through the remote signer.
+	RemoteSigner string
+
+	// GenesisSSZ is the genesis state of the network. It is only used by the
+	// clients that read the genesis validators root from the genesis state.
+	GenesisSSZ []byte
+}
+
+type SignerConfig struct {
+	Spec     []byte
+	Accounts []*Account
 }
 
 type BeaconConfig struct {
@@ -69,18 +145,38 @@ type BeaconConfig struct {
 	Eth1       string
 	Bootnode   string
 	GenesisSSZ []byte
+
+	// Preset is the preset of the network (mainnet or minimal)
+	Preset string
+
+	// Engine is the address of the engine api of the execution node and
+	// JwtSecret the secret to authenticate with it. Both are only set
+	// if the Bellatrix fork is enabled.
+	Engine    string
+	JwtSecret []byte
 }
 
 type ExecutionConfig struct {
-	Bootnode string
-	Genesis  string
-	Key      *wallet.Key
+	Bootnode  string
+	Genesis   string
+	Key       *wallet.Key
+	JwtSecret []byte
 }
 
 type CreateBeacon2 func(cfg *BeaconConfig) (*spec.Spec, error)
 
 type CreateValidator2 func(cfg *ValidatorConfig) (*spec.Spec, error)
 
+// SlashingProtection are the jobs of a validator client that export and import
+// its slashing protection database as an EIP-3076 interchange file. The jobs
+// run over the data of the validator and exit once they are done.
+type SlashingProtection struct {
+	Export CreateValidator2
+	Import CreateValidator2
+}
+
+type CreateExecution func(cfg *ExecutionConfig) (*spec.Spec, error)
+
 type IsNodeDeployRequest_NodeType interface {
 	isNodeDeployRequest_NodeType
 }
@@ -96,5 +192,9 @@ func (a *Account) ToStub() (*AccountStub, error) {
 		PrivKey: hex.EncodeToString(priv),
 		PubKey:  hex.EncodeToString(pubKey[:]),
 	}
+	if a.Index != nil {
+		stub.Derived = true
+		stub.Index = *a.Index
+	}
 	return stub, nil
 }
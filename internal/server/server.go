@@ -2,25 +2,28 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/wallet"
 	"github.com/umbracle/viewpoint/internal/components"
-	"github.com/umbracle/viewpoint/internal/docker"
 	"github.com/umbracle/viewpoint/internal/genesis"
 	"github.com/umbracle/viewpoint/internal/server/proto"
 	"github.com/umbracle/viewpoint/internal/spec"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type Server struct {
@@ -29,48 +32,81 @@ type Server struct {
 	logger hclog.Logger
 
 	eth1HttpAddr   string
+	eth1AuthAddr   string
+	eth1Genesis    string
 	depositHandler *depositHandler
 
-	// runtime to deploy containers
-	docker *docker.Docker
+	// jwtSecret authenticates the beacon nodes with the engine api
+	jwtSecret []byte
+
+	// runtime to deploy the nodes
+	runtime spec.Runtime
 
 	lock   sync.Mutex
 	logDir *logDir
 	nodes  []spec.Node
 
+	// status of the nodes indexed by name
+	status map[string]*nodeStatus
+
+	// watchers tracks the goroutines that watch the exits of the nodes
+	watchers sync.WaitGroup
+
 	bootnodeENR string
 	bootnodeEC  string
 
 	tranches map[uint64]*Tranche
 
+	// keySeed is the seed to derive the keys of the validators and
+	// accountIndex the derivation index of the next account
+	keySeed      []byte
+	accountIndex uint64
+
 	// genesis data
 	genesisSSZ []byte
+
+	grpcServer *grpc.Server
+	grpcAddr   string
+
+	// events of the server sent to the subscribers
+	events *eventBroker
 }
 
-func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
+func NewServer(logger hclog.Logger, config *Config, runtime spec.Runtime) (*Server, error) {
 	// for simplicity we force that there is a perfect division between
 	// the initial validators and the tranches
 	if config.NumGenesisValidators%config.NumTranches != 0 {
 		return nil, fmt.Errorf("genesis validator count not multiple of the tranches, got %d and %d", config.NumGenesisValidators, config.NumTranches)
 	}
-
-	docker, err := docker.NewDocker()
+	if err := config.Spec.Validate(); err != nil {
+		return nil, err
+	}
+	keySeed, err := config.KeySeed()
 	if err != nil {
 		return nil, err
 	}
 
-	logDir, err := newLogDir("e2e-" + config.Name)
+	jwtSecret := make([]byte, 32)
+	if _, err := rand.Read(jwtSecret); err != nil {
+		return nil, err
+	}
+
+	logDir, err := newLogDir(config.DataDir, "e2e-"+config.Name)
 	if err != nil {
 		return nil, err
 	}
 
 	srv := &Server{
-		config:   config,
-		logger:   logger,
-		docker:   docker,
-		nodes:    []spec.Node{},
-		logDir:   logDir,
-		tranches: map[uint64]*Tranche{},
+		config:    config,
+		logger:    logger,
+		runtime:   runtime,
+		nodes:     []spec.Node{},
+		status:    map[string]*nodeStatus{},
+		logDir:    logDir,
+		tranches:  map[uint64]*Tranche{},
+		events:    newEventBroker(),
+		jwtSecret: jwtSecret,
+		keySeed:   keySeed,
 	}
 
 	// deploy bootnode
@@ -97,6 +133,11 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to create genesis.szz file: %v", err)
 	}
 
+	// persist the state to resume the server later
+	if err := srv.saveState(); err != nil {
+		return nil, fmt.Errorf("failed to save state: %v", err)
+	}
+
 	// start the grpc server
 	if err := srv.setupGrpcServer(); err != nil {
 		return nil, fmt.Errorf("failed to start grpc server: %v", err)
@@ -106,54 +147,240 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 	if srv.config.Spec.Altair != nil {
 		logger.Info("altair fork enabled", "epoch", *srv.config.Spec.Altair)
 	}
+	if srv.config.Spec.Bellatrix != nil {
+		logger.Info("bellatrix fork enabled", "epoch", *srv.config.Spec.Bellatrix, "ttd", srv.config.Spec.TerminalTotalDifficulty)
+	}
+
+	return srv, nil
+}
+
+// ResumeConfig is the configuration to resume an existing network
+type ResumeConfig struct {
+	// Name is the name of the network
+	Name string
+
+	// DataDir is the directory with the e2e-<name> folder of the
+	// network. It defaults to the working directory.
+	DataDir string
+
+	// GrpcToken is the bearer token required by the grpc server.
+	// It is not part of the state of the network.
+	GrpcToken string
+}
+
+// ResumeServer starts a server for an existing network using the state stored
+// in its e2e folder. The nodes that are not running anymore are tracked as
+// stopped or exited and they can be started again with NodeStart.
+func ResumeServer(logger hclog.Logger, resume *ResumeConfig, runtime spec.Runtime) (*Server, error) {
+	name := resume.Name
+
+	logDir, err := openLogDir(resume.DataDir, "e2e-"+name)
+	if err != nil {
+		return nil, err
+	}
+	st, err := readState(logDir)
+	if err != nil {
+		return nil, err
+	}
+	// the folder of the network might have been moved
+	st.Config.DataDir = resume.DataDir
+	st.Config.GrpcToken = resume.GrpcToken
+
+	keySeed, err := st.Config.KeySeed()
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &Server{
+		config:       st.Config,
+		logger:       logger,
+		runtime:      runtime,
+		nodes:        []spec.Node{},
+		status:       map[string]*nodeStatus{},
+		logDir:       logDir,
+		tranches:     map[uint64]*Tranche{},
+		bootnodeENR:  st.BootnodeENR,
+		bootnodeEC:   st.BootnodeEC,
+		eth1Genesis:  st.Eth1Genesis,
+		events:       newEventBroker(),
+		keySeed:      keySeed,
+		accountIndex: st.AccountIndex,
+	}
+
+	// attach to the nodes of the network
+	for _, nodeSt := range st.Nodes {
+		spec := nodeSt.toSpec()
+
+		fLogger, err := logDir.CreateLogFile(spec.Name)
+		if err != nil {
+			return nil, err
+		}
+		spec.WithOutput(fLogger)
+
+		node, err := runtime.Attach(spec, nodeSt.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach to node '%s': %v", spec.Name, err)
+		}
+		srv.nodes = append(srv.nodes, node)
+
+		select {
+		case <-node.WaitCh():
+			logger.Info("node is not running", "name", spec.Name, "stopped", nodeSt.Stopped)
+			srv.trackExitedNode(node, nodeSt.Stopped)
+		default:
+			srv.trackNode(node)
+		}
+
+		if spec.Name == "eth1" {
+			srv.eth1HttpAddr = node.GetAddr(proto.NodePortEth1Http)
+			srv.eth1AuthAddr = node.GetAddr(proto.NodePortEth1AuthRPC)
+
+			// the server needs the eth1 node to send the deposits
+			if srv.status[spec.Name].state != proto.NodeState_Running {
+				logger.Info("start eth1 node")
+				if err := srv.startNode(node); err != nil {
+					return nil, fmt.Errorf("failed to start eth1 node: %v", err)
+				}
+			}
+		}
+	}
+	if srv.eth1HttpAddr == "" {
+		return nil, fmt.Errorf("eth1 node not found")
+	}
+
+	// load the deposit handler with the existing contract
+	depositKey, err := hex.DecodeString(st.DepositKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := wallet.NewWalletFromPrivKey(depositKey)
+	if err != nil {
+		return nil, err
+	}
+	if srv.depositHandler, err = loadDepositHandler(srv.eth1HttpAddr, key, ethgo.HexToAddress(st.DepositContract), st.DepositNonce); err != nil {
+		return nil, err
+	}
+	srv.depositHandler.publish = srv.publish
+	srv.depositHandler.forkVersion = srv.config.Spec.GenesisForkVersion
+
+	if srv.jwtSecret, err = hex.DecodeString(st.JwtSecret); err != nil {
+		return nil, err
+	}
+
+	for index, trancheSt := range st.Tranches {
+		tranche, err := trancheSt.toTranche()
+		if err != nil {
+			return nil, err
+		}
+		srv.tranches[index] = tranche
+	}
 
+	if srv.genesisSSZ, err = ioutil.ReadFile(filepath.Join(logDir.path, "genesis.ssz")); err != nil {
+		return nil, err
+	}
+
+	// start the grpc server
+	if err := srv.setupGrpcServer(); err != nil {
+		return nil, fmt.Errorf("failed to start grpc server: %v", err)
+	}
+
+	logger.Info("server resumed", "name", name, "nodes", len(srv.nodes), "tranches", len(srv.tranches))
 	return srv, nil
 }
 
+// persist saves the state of the server and logs any error
+func (s *Server) persist() {
+	if err := s.saveState(); err != nil {
+		s.logger.Error("failed to save state", "err", err)
+	}
+}
+
 func (s *Server) setupEth1Network() error {
 	genesis, key, err := components.NewDevGenesis()
 	if err != nil {
 		return err
 	}
 
+	if bellatrix := s.config.Spec.Bellatrix; bellatrix != nil {
+		if *bellatrix == 0 {
+			// the chain starts merged and there are no blocks
+			// to deploy the deposit contract before the genesis
+			contract, err := depositContractGenesis()
+			if err != nil {
+				return err
+			}
+			genesis.PostMerge = true
+			genesis.TDD = 0
+			genesis.Contracts = map[ethgo.Address]*components.GenesisContract{
+				genesisDepositContract: contract,
+			}
+		} else {
+			// the clique chain reaches the terminal total difficulty
+			// around the time of the bellatrix fork
+			genesis.TDD = genesis.TotalDifficultyAt(time.Until(s.config.Spec.forkTime(*bellatrix)))
+		}
+		s.config.Spec.TerminalTotalDifficulty = genesis.TDD
+	}
+
 	genesisRaw, err := genesis.Build()
 	if err != nil {
 		return err
 	}
+	s.eth1Genesis = genesisRaw
 
 	config := &proto.ExecutionConfig{
-		Bootnode: s.bootnodeEC,
-		Genesis:  genesisRaw,
-		Key:      key,
+		Bootnode:  s.bootnodeEC,
+		Genesis:   genesisRaw,
+		Key:       key,
+		JwtSecret: s.jwtSecret,
+	}
+	if genesis.PostMerge {
+		// there are no blocks to seal with clique
+		config.Key = nil
 	}
 	eth1, err := s.deployNode(components.NewEth1Server(config).WithName("eth1"))
 	if err != nil {
 		return err
 	}
 	s.eth1HttpAddr = eth1.GetAddr(proto.NodePortEth1Http)
+	s.eth1AuthAddr = eth1.GetAddr(proto.NodePortEth1AuthRPC)
 	s.logger.Info("eth1 server deployed", "addr", s.eth1HttpAddr)
 
 	// deploy depositHandler
-	if s.depositHandler, err = newDepositHandler(s.eth1HttpAddr, key); err != nil {
+	if genesis.PostMerge {
+		s.depositHandler, err = loadDepositHandler(s.eth1HttpAddr, key, genesisDepositContract, -1)
+	} else {
+		s.depositHandler, err = newDepositHandler(s.eth1HttpAddr, key)
+	}
+	if err != nil {
 		return err
 	}
+	s.depositHandler.publish = s.publish
+	s.depositHandler.forkVersion = s.config.Spec.GenesisForkVersion
 	s.logger.Info("deposit contract deployed", "addr", s.depositHandler.deposit.String())
 	s.config.Spec.DepositContract = s.depositHandler.deposit.String()
 
 	return nil
 }
 
-var (
-	altairForkVersion = [4]byte{0x80, 0x0, 0x0, 0x70}
-)
-
 func (s *Server) setupGenesis() error {
 	// create the tranches and initial accounts
 	numAccountsPerTranche := s.config.NumGenesisValidators / s.config.NumTranches
 
 	initialAccounts := []*proto.Account{}
 	for i := 0; i < int(s.config.NumTranches); i++ {
-		tranche, err := s.createTranche(int(numAccountsPerTranche), false)
+		tranche, err := s.createTranche(int(numAccountsPerTranche))
+		if err != nil {
+			return err
+		}
+		initialAccounts = append(initialAccounts, tranche.Accounts...)
+	}
+	if len(s.config.GenesisAccounts) != 0 {
+		// the imported accounts are included in their own tranche
+		if err := s.checkNewAccounts(s.config.GenesisAccounts); err != nil {
+			return err
+		}
+		tranche, err := s.saveTranche(s.config.GenesisAccounts, nil)
 		if err != nil {
 			return err
 		}
@@ -174,11 +401,28 @@ func (s *Server) setupGenesis() error {
 		Eth1Block:        block,
 		GenesisTime:      int64(s.config.Spec.MinGenesisTime),
 		InitialValidator: initialAccounts,
+		ForkVersion:      s.config.Spec.GenesisForkVersion,
 	}
-	if altair := s.config.Spec.Altair; altair != nil && *altair == 0 {
-		// enable altair fork in genesis (TODO: Remove hardcode)
+	if preset, ok := genesis.PresetByName(s.config.Spec.Preset); ok {
+		input.Preset = preset
+	}
+	if bellatrix := s.config.Spec.Bellatrix; bellatrix != nil && *bellatrix == 0 {
+		// start the chain at the merge with the genesis block of the execution chain
+		var execBlock genesis.ExecutionBlock
+		if err := provider.Call("eth_getBlockByNumber", &execBlock, "0x0", false); err != nil {
+			return err
+		}
+		header, err := execBlock.PayloadHeader()
+		if err != nil {
+			return err
+		}
+		input.Fork = proto.Fork_Merge
+		input.ForkVersion = s.config.Spec.BellatrixForkVersion
+		input.ExecutionPayloadHeader = header
+	} else if altair := s.config.Spec.Altair; altair != nil && *altair == 0 {
+		// enable altair fork in genesis
 		input.Fork = proto.Fork_Altair
-		input.ForkVersion = altairForkVersion
+		input.ForkVersion = s.config.Spec.AltairForkVersion
 	}
 
 	state, err := genesis.GenerateGenesis(input)
@@ -192,31 +436,76 @@ func (s *Server) setupGenesis() error {
 	if _, err := s.logDir.writeFile("spec.yaml", s.config.Spec.buildConfig()); err != nil {
 		return err
 	}
-	if _, err := s.logDir.writeFile("genesis.ssz", s.genesisSSZ); err != nil {
+	genesisPath, err := s.logDir.writeFile("genesis.ssz", s.genesisSSZ)
+	if err != nil {
 		return err
 	}
+
+	s.publish(&proto.Event{
+		Event: &proto.Event_GenesisWritten_{
+			GenesisWritten: &proto.Event_GenesisWritten{
+				Path:        genesisPath,
+				GenesisTime: uint64(input.GenesisTime),
+			},
+		},
+	})
 	return nil
 }
 
 func (s *Server) setupGrpcServer() error {
-	grpcServer := grpc.NewServer()
+	opts := []grpc.ServerOption{}
+	if s.config.GrpcTLS {
+		tlsConfig, err := s.grpcTLSConfig()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	if s.config.GrpcToken != "" {
+		auth := &tokenAuth{token: s.config.GrpcToken}
+		opts = append(opts, grpc.UnaryInterceptor(auth.unary), grpc.StreamInterceptor(auth.stream))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 	proto.RegisterE2EServiceServer(grpcServer, s)
 
-	grpcAddr := "localhost:5555"
-	lis, err := net.Listen("tcp", grpcAddr)
+	lis, grpcAddr, err := listenGrpc(s.config.GrpcAddr)
 	if err != nil {
 		return err
 	}
 
+	// write the address in the e2e folder since it might be a random port
+	if _, err := s.logDir.writeFile(grpcAddrFile, []byte(grpcAddr)); err != nil {
+		lis.Close()
+		return err
+	}
+
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
 			s.logger.Error("failed to serve grpc server", "err", err)
 		}
 	}()
-	s.logger.Info("GRPC Server started", "addr", grpcAddr)
+	s.grpcServer = grpcServer
+	s.grpcAddr = grpcAddr
+
+	s.logger.Info("GRPC Server started", "addr", grpcAddr, "tls", s.config.GrpcTLS, "auth", s.config.GrpcToken != "")
 	return nil
 }
 
+// GrpcAddr returns the address of the grpc server
+func (s *Server) GrpcAddr() string {
+	return s.grpcAddr
+}
+
+// GrpcCertFile returns the path of the TLS certificate of the
+// grpc server or an empty string if TLS is not enabled
+func (s *Server) GrpcCertFile() string {
+	if !s.config.GrpcTLS {
+		return ""
+	}
+	return filepath.Join(s.logDir.path, grpcCertFile)
+}
+
 func (s *Server) deployNode(spec *spec.Spec) (spec.Node, error) {
 	fLogger, err := s.logDir.CreateLogFile(spec.Name)
 	if err != nil {
@@ -226,21 +515,61 @@ func (s *Server) deployNode(spec *spec.Spec) (spec.Node, error) {
 		WithLabel("viewpoint", "true").
 		WithLabel("env", s.config.Name)
 
-	node, err := s.docker.Deploy(spec)
+	hasReadiness := spec.Retry != nil
+	if hasReadiness {
+		s.trackReadiness(spec)
+	}
+
+	node, err := s.runtime.Deploy(spec)
 	if err != nil {
 		return nil, err
 	}
 	s.nodes = append(s.nodes, node)
+
+	if !hasReadiness {
+		s.publishNodeDeployed(node)
+		s.publishNodeReady(node)
+	}
+	s.trackNode(node)
 	return node, nil
 }
 
+// trackReadiness wraps the readiness check of the spec to publish an event
+// once the node starts and every time the check passes
+func (s *Server) trackReadiness(nodeSpec *spec.Spec) {
+	retry := nodeSpec.Retry
+
+	started := false
+	nodeSpec.WithRetry(func(n spec.Node) error {
+		if !started {
+			started = true
+			s.publishNodeDeployed(n)
+		}
+		if err := retry(n); err != nil {
+			return err
+		}
+		s.publishNodeReady(n)
+		return nil
+	})
+}
+
 func (s *Server) Stop() {
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+
 	// stop all servers
 	for _, node := range s.nodes {
+		if status, ok := s.status[node.Spec().Name]; ok {
+			status.stop()
+		}
 		if err := node.Stop(); err != nil {
 			s.logger.Error("failed to stop node", "id", "x", "err", err)
 		}
 	}
+	// wait for the watchers to record the exits of the nodes
+	s.watchers.Wait()
+
 	if err := s.logDir.Close(); err != nil {
 		s.logger.Error("failed to close file logger", "err", err.Error())
 	}
@@ -260,12 +589,19 @@ type Tranche struct {
 	Accounts  []*proto.Account
 	Filepath  string
 	Validator string
+
+	// Deposits are the deposits sent for the accounts
+	Deposits []*proto.DepositStub
+
+	// busy is true while the deposits of the tranche are sent
+	busy bool
 }
 
 func (t *Tranche) ToProto() (*proto.TrancheStub, error) {
 	res := &proto.TrancheStub{
-		Name: t.Validator,
-		Path: t.Filepath,
+		Name:     t.Validator,
+		Path:     t.Filepath,
+		Deposits: t.Deposits,
 	}
 	for _, acct := range t.Accounts {
 		stub, err := acct.ToStub()
@@ -281,16 +617,32 @@ func (t *Tranche) IsConsumed() bool {
 	return t.Validator != ""
 }
 
-// createTranche creates a new tranche object including the deposits
-func (s *Server) createTranche(numValidators int, deposit bool) (*Tranche, error) {
-	accounts := proto.NewAccounts(numValidators)
+// createTranche creates a tranche with new accounts without deposits
+func (s *Server) createTranche(numValidators int) (*Tranche, error) {
+	accounts, err := s.newAccounts(numValidators)
+	if err != nil {
+		return nil, err
+	}
+	return s.saveTranche(accounts, nil)
+}
 
-	if deposit {
-		if err := s.depositHandler.MakeDeposits(accounts); err != nil {
-			return nil, err
-		}
+// newAccounts returns the accounts of a new tranche. If the keys are derived
+// from the mnemonic, the range of derivation indexes is reserved for them.
+func (s *Server) newAccounts(numValidators int) ([]*proto.Account, error) {
+	if s.keySeed == nil {
+		return proto.NewAccounts(numValidators), nil
 	}
+	// each tranche takes the next range of derivation indexes
+	accounts, err := proto.NewDerivedAccounts(s.keySeed, s.accountIndex, numValidators)
+	if err != nil {
+		return nil, err
+	}
+	s.accountIndex += uint64(numValidators)
+	return accounts, nil
+}
 
+// saveTranche creates a new tranche object with the accounts and the deposits already sent
+func (s *Server) saveTranche(accounts []*proto.Account, deposits []*proto.DepositStub) (*Tranche, error) {
 	// create a tranche file on the datadir
 	privKeys := []string{}
 	for _, acct := range accounts {
@@ -310,13 +662,54 @@ func (s *Server) createTranche(numValidators int, deposit bool) (*Tranche, error
 	tranche := &Tranche{
 		Accounts: accounts,
 		Filepath: tranchPath,
+		Deposits: deposits,
 	}
 	s.tranches[uint64(numTranches)] = tranche
 
 	s.logger.Info("tranche created", "index", uint64(numTranches), "num-accounts", len(accounts), "path", tranchPath)
+
+	s.publish(&proto.Event{
+		Event: &proto.Event_TrancheCreated_{
+			TrancheCreated: &proto.Event_TrancheCreated{
+				Index:         uint64(numTranches),
+				NumValidators: uint64(len(accounts)),
+				Path:          tranchPath,
+			},
+		},
+	})
 	return tranche, nil
 }
 
+// saveDeposits stores the deposits sent for the tranche and releases it. The deposits
+// are stored even if the others failed since the chain might still process them.
+func (s *Server) saveDeposits(index uint64, tranche *Tranche, deposits []*proto.DepositStub, depositErr error) error {
+	tranche.busy = false
+	tranche.Deposits = append(tranche.Deposits, deposits...)
+	if depositErr != nil {
+		return fmt.Errorf("deposits of tranche %d failed with %d deposits sent: %v", index, len(deposits), depositErr)
+	}
+	return nil
+}
+
+// checkNewAccounts checks that the accounts are not duplicated
+// and that they are not part of any other tranche
+func (s *Server) checkNewAccounts(accounts []*proto.Account) error {
+	used := map[[48]byte]struct{}{}
+	for _, tranche := range s.tranches {
+		for _, acct := range tranche.Accounts {
+			used[acct.Bls.PubKey()] = struct{}{}
+		}
+	}
+	for _, acct := range accounts {
+		pub := acct.Bls.PubKey()
+		if _, ok := used[pub]; ok {
+			return fmt.Errorf("validator 0x%s already exists", hex.EncodeToString(pub[:]))
+		}
+		used[pub] = struct{}{}
+	}
+	return nil
+}
+
 func (s *Server) DepositList(ctx context.Context, req *proto.DepositListRequest) (*proto.DepositListResponse, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -330,6 +723,9 @@ func (s *Server) DepositList(ctx context.Context, req *proto.DepositListRequest)
 		stub.Index = index
 		res = append(res, stub)
 	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Index < res[j].Index
+	})
 
 	resp := &proto.DepositListResponse{
 		Tranches: res,
@@ -337,12 +733,50 @@ func (s *Server) DepositList(ctx context.Context, req *proto.DepositListRequest)
 	return resp, nil
 }
 
+// DepositCreate sends the deposits of a new tranche or tops up an existing one. The
+// deposits are sent without the lock of the server since they take multiple blocks,
+// the tranche is created before and it is busy until the deposits are saved.
 func (s *Server) DepositCreate(ctx context.Context, req *proto.DepositCreateRequest) (*proto.DepositCreateResponse, error) {
+	opts, err := depositOptsFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	var index uint64
+	var tranche *Tranche
+	if req.TopUp {
+		var ok bool
+		index = req.Tranche
+		if tranche, ok = s.tranches[index]; !ok {
+			s.lock.Unlock()
+			return nil, fmt.Errorf("tranche %d does not exists", index)
+		}
+		if tranche.busy {
+			s.lock.Unlock()
+			return nil, fmt.Errorf("tranche %d has deposits in progress", index)
+		}
+		s.logger.Info("top up tranche", "index", index, "amount", opts.amount)
+	} else {
+		index = uint64(len(s.tranches))
+		if tranche, err = s.createTranche(int(req.NumValidators)); err != nil {
+			s.lock.Unlock()
+			return nil, err
+		}
+	}
+	tranche.busy = true
+	handler := s.depositHandler
+	s.persist()
+	s.lock.Unlock()
+
+	deposits, err := handler.MakeDeposits(ctx, tranche.Accounts, opts)
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	// the nonce and the deposits sent are persisted even if the deposits fail
+	defer s.persist()
 
-	tranche, err := s.createTranche(int(req.NumValidators), true)
-	if err != nil {
+	if err := s.saveDeposits(index, tranche, deposits, err); err != nil {
 		return nil, err
 	}
 
@@ -356,10 +790,160 @@ func (s *Server) DepositCreate(ctx context.Context, req *proto.DepositCreateRequ
 	return resp, nil
 }
 
+// depositOptsFromRequest validates the parameters of the deposits of the request
+func depositOptsFromRequest(req *proto.DepositCreateRequest) (*depositOpts, error) {
+	opts := defaultDepositOpts()
+	if req.Amount != 0 {
+		if req.Amount < minDepositAmount {
+			return nil, fmt.Errorf("deposit amount %d is lower than the minimum of %d gwei", req.Amount, minDepositAmount)
+		}
+		opts.amount = req.Amount
+	}
+
+	if _, ok := proto.InvalidDeposit_name[int32(req.Invalid)]; !ok {
+		return nil, fmt.Errorf("invalid deposit kind %d not found", req.Invalid)
+	}
+	if req.Invalid == proto.InvalidDeposit_InvalidAmount && req.Amount != 0 {
+		return nil, fmt.Errorf("amount cannot be set with invalid amount deposits")
+	}
+	opts.invalid = req.Invalid
+
+	if req.TopUp {
+		if req.Invalid != proto.InvalidDeposit_NoInvalidDeposit {
+			return nil, fmt.Errorf("invalid deposits cannot be made on a top up")
+		}
+		// the withdrawal credentials of a top up are ignored by the consensus layer
+		if req.WithdrawalCredentials != proto.WithdrawalCredentials_BlsCredentials || req.WithdrawalAddress != "" {
+			return nil, fmt.Errorf("withdrawal credentials cannot be set on a top up")
+		}
+		if req.NumValidators != 0 {
+			return nil, fmt.Errorf("number of validators cannot be set on a top up")
+		}
+		return opts, nil
+	}
+
+	switch req.WithdrawalCredentials {
+	case proto.WithdrawalCredentials_BlsCredentials:
+		if req.WithdrawalAddress != "" {
+			return nil, fmt.Errorf("withdrawal address requires execution withdrawal credentials")
+		}
+	case proto.WithdrawalCredentials_ExecutionCredentials:
+		if req.WithdrawalAddress == "" {
+			return nil, fmt.Errorf("execution withdrawal credentials require a withdrawal address")
+		}
+		if err := opts.withdrawalAddress.UnmarshalText([]byte(req.WithdrawalAddress)); err != nil {
+			return nil, fmt.Errorf("invalid withdrawal address '%s': %v", req.WithdrawalAddress, err)
+		}
+	default:
+		return nil, fmt.Errorf("withdrawal credentials %s not found", req.WithdrawalCredentials)
+	}
+	opts.credentials = req.WithdrawalCredentials
+	return opts, nil
+}
+
+// DepositImport creates a tranche with the keys of the request and sends their deposits
+// without the lock of the server, like DepositCreate.
+func (s *Server) DepositImport(ctx context.Context, req *proto.DepositImportRequest) (*proto.DepositImportResponse, error) {
+	if len(req.Keys) == 0 {
+		return nil, fmt.Errorf("no keys to import")
+	}
+	accounts := []*proto.Account{}
+	for i, priv := range req.Keys {
+		key, err := proto.KeyFromPriv(priv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d: %v", i, err)
+		}
+		accounts = append(accounts, proto.NewAccountFromKey(key))
+	}
+
+	s.lock.Lock()
+	if err := s.checkNewAccounts(accounts); err != nil {
+		s.lock.Unlock()
+		return nil, err
+	}
+	index := uint64(len(s.tranches))
+	tranche, err := s.saveTranche(accounts, nil)
+	if err != nil {
+		s.lock.Unlock()
+		return nil, err
+	}
+	tranche.busy = true
+	handler := s.depositHandler
+	s.persist()
+	s.lock.Unlock()
+
+	deposits, err := handler.MakeDeposits(ctx, accounts, defaultDepositOpts())
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.persist()
+
+	if err := s.saveDeposits(index, tranche, deposits, err); err != nil {
+		return nil, err
+	}
+
+	stub, err := tranche.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.DepositImportResponse{
+		Tranche: stub,
+	}
+	return resp, nil
+}
+
 func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (*proto.NodeDeployResponse, error) {
+	valReq, ok := req.NodeType.(*proto.NodeDeployRequest_Validator_)
+	if !ok || valReq.Validator.NumValidators == 0 {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		defer s.persist()
+
+		return s.nodeDeployLocked(req)
+	}
+
+	// the validator requires a new tranche. Its deposits are sent without the lock
+	// of the server and the validator is deployed with the tranche afterwards.
+	if err := s.validateNodeDeploy(req); err != nil {
+		return nil, err
+	}
+	if _, ok := validatorsFactory[req.NodeClient]; !ok {
+		return nil, fmt.Errorf("validator client %s not found", req.NodeClient)
+	}
+
+	s.lock.Lock()
+	index := uint64(len(s.tranches))
+	tranche, err := s.createTranche(int(valReq.Validator.NumValidators))
+	if err != nil {
+		s.lock.Unlock()
+		return nil, err
+	}
+	tranche.busy = true
+	handler := s.depositHandler
+	s.persist()
+	s.lock.Unlock()
+
+	deposits, err := handler.MakeDeposits(ctx, tranche.Accounts, defaultDepositOpts())
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	defer s.persist()
+
+	if err := s.saveDeposits(index, tranche, deposits, err); err != nil {
+		return nil, err
+	}
 
+	valReq.Validator.NumTranch = index
+	valReq.Validator.NumValidators = 0
+
+	resp, err := s.nodeDeployLocked(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy the validator of tranche %d: %v", index, err)
+	}
+	return resp, nil
+}
+
+func (s *Server) nodeDeployLocked(req *proto.NodeDeployRequest) (*proto.NodeDeployResponse, error) {
 	numOfNodes := func(typ proto.NodeType) int {
 		nodes := s.filterLocked(func(spec *spec.Spec) bool {
 			return spec.HasLabel(proto.NodeTypeLabel, typ.String())
@@ -367,31 +951,93 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 		return len(nodes)
 	}
 
+	nodeName := func(typ proto.NodeType, client string) string {
+		// skip the names already in use since some nodes might have been removed
+		for i := numOfNodes(typ); ; i++ {
+			name := fmt.Sprintf("%s-%d-%s", strings.ToLower(typ.String()), i, strings.ToLower(client))
+			if _, err := s.findNodeLocked(name); err != nil {
+				return name
+			}
+		}
+	}
+
+	restartPolicy, ok := restartPolicies[req.RestartPolicy]
+	if !ok {
+		return nil, fmt.Errorf("restart policy %s not found", req.RestartPolicy)
+	}
+
 	createdNodes := []*proto.Node{}
 
+	startNode := func(name string, spec *spec.Spec) (spec.Node, error) {
+		spec.WithName(name).
+			WithRestartPolicy(restartPolicy, req.MaxRetries)
+
+		node, err := s.deployNode(spec)
+		if err != nil {
+			return nil, err
+		}
+		stub, err := specNodeToNode(node)
+		if err != nil {
+			return nil, err
+		}
+		createdNodes = append(createdNodes, stub)
+		return node, nil
+	}
+
 	deployNode := func(name string, spec *spec.Spec) (spec.Node, error) {
-		spec.WithName(name)
+		if req.Repo == "" && req.Tag == "" {
+			// use the image of the client built with the preset of the network
+			if image, ok := presetImages[s.config.Spec.Preset][req.NodeClient][spec.Labels[proto.NodeTypeLabel]]; ok {
+				spec = spec.WithContainer(image.repo).WithTag(image.tag)
+			}
+		}
 		if req.Repo != "" {
 			spec = spec.WithContainer(req.Repo)
 		}
 		if req.Tag != "" {
 			spec = spec.WithTag(req.Tag)
 		}
+		return startNode(name, spec)
+	}
 
-		node, err := s.deployNode(spec)
-		if err != nil {
-			return nil, err
+	deployExecution := func(beaconName string) (spec.Node, error) {
+		name := nodeName(proto.NodeType_Execution, req.ExecutionClient.String())
+		s.logger.Info("deploy execution node", "name", name, "beacon", beaconName)
+
+		// the node is not a miner and syncs the chain from the
+		// other execution nodes through the bootnode
+		eCfg := &proto.ExecutionConfig{
+			Bootnode:  s.bootnodeEC,
+			Genesis:   s.eth1Genesis,
+			JwtSecret: s.jwtSecret,
 		}
-		stub, err := specNodeToNode(node)
+
+		factory, ok := executionFactory[req.ExecutionClient]
+		if !ok {
+			return nil, fmt.Errorf("execution client %s not found", req.ExecutionClient)
+		}
+
+		spec, err := factory(eCfg)
 		if err != nil {
 			return nil, err
 		}
-		createdNodes = append(createdNodes, stub)
-		return node, nil
+		if beaconName != "" {
+			spec.WithLabel(proto.NodePairLabel, beaconName)
+		} else {
+			// the repo and tag of the request only apply to
+			// the execution nodes if they are deployed on their own
+			if req.Repo != "" {
+				spec.WithContainer(req.Repo)
+			}
+			if req.Tag != "" {
+				spec.WithTag(req.Tag)
+			}
+		}
+		return startNode(name, spec)
 	}
 
-	deployBeacon := func() (spec.Node, error) {
-		name := fmt.Sprintf("beacon-%d-%s", numOfNodes(proto.NodeType_Beacon), strings.ToLower(req.NodeClient.String()))
+	deployBeacon := func(withExecution bool) (node spec.Node, err error) {
+		name := nodeName(proto.NodeType_Beacon, req.NodeClient.String())
 		s.logger.Info("deploy beacon node", "name", name)
 
 		bCfg := &proto.BeaconConfig{
@@ -399,25 +1045,64 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 			Eth1:       s.eth1HttpAddr,
 			GenesisSSZ: s.genesisSSZ,
 			Bootnode:   s.bootnodeENR,
+			Preset:     s.config.Spec.Preset,
+		}
+		engine := s.eth1AuthAddr
+
+		var execution spec.Node
+		if withExecution {
+			if execution, err = deployExecution(name); err != nil {
+				return nil, err
+			}
+			// the execution node is not used if the beacon node fails to deploy
+			defer func() {
+				if err == nil {
+					return
+				}
+				if rErr := s.removeNodeLocked(execution); rErr != nil {
+					s.logger.Error("failed to remove execution node", "name", execution.Spec().Name, "err", rErr)
+				}
+				createdNodes = createdNodes[:len(createdNodes)-1]
+			}()
+
+			bCfg.Eth1 = execution.GetAddr(proto.NodePortEth1Http)
+			engine = execution.GetAddr(proto.NodePortEth1AuthRPC)
+		}
+		if s.config.Spec.Bellatrix != nil {
+			bCfg.Engine = engine
+			bCfg.JwtSecret = s.jwtSecret
 		}
 
 		factory, ok := beaconFactory[req.NodeClient]
 		if !ok {
-			return nil, fmt.Errorf("validator client %s not found", req.NodeClient)
+			return nil, fmt.Errorf("beacon client %s not found", req.NodeClient)
 		}
 
 		spec, err := factory(bCfg)
 		if err != nil {
 			return nil, err
 		}
-		node, err := deployNode(name, spec)
-		if err != nil {
+		if execution != nil {
+			spec.WithLabel(proto.NodePairLabel, execution.Spec().Name)
+		}
+		if node, err = deployNode(name, spec); err != nil {
 			return nil, err
 		}
 		return node, nil
 	}
 
 	deployValidator := func(deploy *proto.NodeDeployRequest_Validator, target spec.Node) (spec.Node, error) {
+		if target == nil && deploy.Beacon != "" {
+			// connect to the beacon node given in the request
+			beacon, err := s.findNodeLocked(deploy.Beacon)
+			if err != nil {
+				return nil, err
+			}
+			if !beacon.Spec().HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()) {
+				return nil, fmt.Errorf("node '%s' is not a beacon node", deploy.Beacon)
+			}
+			target = beacon
+		}
 		if target == nil {
 			// pick a beacon node to connect that is of the same type as the validator
 			beacons := s.filterLocked(func(spec *spec.Spec) bool {
@@ -441,21 +1126,26 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 			if tranche.IsConsumed() {
 				return nil, fmt.Errorf("tranche '%d' has already been used", deploy.NumTranch)
 			}
-		} else {
-			// create a new tranch (with deposit)
-			var err error
-			if tranche, err = s.createTranche(int(deploy.NumValidators), true); err != nil {
-				return nil, err
+			if tranche.busy {
+				return nil, fmt.Errorf("tranche '%d' has deposits in progress", deploy.NumTranch)
 			}
+		} else {
+			// the tranche of the validator is created with its deposits by NodeDeploy
+			return nil, fmt.Errorf("validators with new accounts are deployed with NodeDeploy")
 		}
 
-		name := fmt.Sprintf("validator-%d-%s", numOfNodes(proto.NodeType_Validator), strings.ToLower(req.NodeClient.String()))
+		name := nodeName(proto.NodeType_Validator, req.NodeClient.String())
 		s.logger.Info("deploy validator node", "name", name)
 
 		vCfg := &proto.ValidatorConfig{
-			Accounts: tranche.Accounts,
-			Spec:     s.config.Spec.buildConfig(),
-			Beacon:   target,
+			Accounts:   tranche.Accounts,
+			Spec:       s.config.Spec.buildConfig(),
+			Beacon:     target,
+			Preset:     s.config.Spec.Preset,
+			GenesisSSZ: s.genesisSSZ,
+		}
+		if s.config.Spec.Bellatrix != nil {
+			vCfg.FeeRecipient = s.depositHandler.key.Address().String()
 		}
 
 		factory, ok := validatorsFactory[req.NodeClient]
@@ -463,10 +1153,47 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 			return nil, fmt.Errorf("validator client %s not found", req.NodeClient)
 		}
 
+		var signer spec.Node
+		if deploy.RemoteSigner {
+			// deploy a web3signer with the keys of the tranche
+			signerName := nodeName(proto.NodeType_Signer, components.Web3SignerClient)
+			s.logger.Info("deploy signer node", "name", signerName, "validator", name)
+
+			signerSpec, err := components.NewWeb3Signer(&proto.SignerConfig{
+				Spec:     vCfg.Spec,
+				Accounts: tranche.Accounts,
+			})
+			if err != nil {
+				return nil, err
+			}
+			signerSpec.WithLabel(proto.NodePairLabel, name)
+
+			// the repo and tag of the request only apply to the validator
+			if signer, err = startNode(signerName, signerSpec); err != nil {
+				return nil, err
+			}
+			vCfg.RemoteSigner = signer.GetAddr(proto.NodePortWeb3Signer)
+		}
+
 		spec, err := factory(vCfg)
 		if err != nil {
 			return nil, err
 		}
+		if signer != nil {
+			spec.WithLabel(proto.NodePairLabel, signer.Spec().Name)
+		}
+		if len(deploy.SlashingProtection) != 0 {
+			// the import job runs with the same image of the validator
+			if req.Repo != "" {
+				spec.WithContainer(req.Repo)
+			}
+			if req.Tag != "" {
+				spec.WithTag(req.Tag)
+			}
+			if err := s.importSlashingProtection(name, req.NodeClient, vCfg, spec, deploy.SlashingProtection); err != nil {
+				return nil, err
+			}
+		}
 		node, err := deployNode(name, spec)
 		if err != nil {
 			return nil, err
@@ -477,13 +1204,31 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 		return node, nil
 	}
 
+	if execReq, ok := req.NodeType.(*proto.NodeDeployRequest_Execution_); ok {
+		// deploy standalone execution nodes
+		for i := 0; i < int(execReq.Execution.Count); i++ {
+			if _, err := deployExecution(""); err != nil {
+				return nil, err
+			}
+		}
+		resp := &proto.NodeDeployResponse{
+			Nodes: createdNodes,
+		}
+		return resp, nil
+	}
+
+	if err := s.validateNodeDeploy(req); err != nil {
+		return nil, err
+	}
+
 	beaconReq, ok := req.NodeType.(*proto.NodeDeployRequest_Beacon_)
 	if !ok {
 		// we still have to deploy beacon nodes if requested by a validator
 		if valReq := req.NodeType.(*proto.NodeDeployRequest_Validator_); valReq.Validator.WithBeacon {
 			beaconReq = &proto.NodeDeployRequest_Beacon_{
 				Beacon: &proto.NodeDeployRequest_Beacon{
-					Count: valReq.Validator.BeaconCount,
+					Count:         valReq.Validator.BeaconCount,
+					WithExecution: valReq.Validator.BeaconWithExecution,
 				},
 			}
 		}
@@ -493,7 +1238,7 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 	if beaconReq != nil {
 		// deploy beacon nodes
 		for i := 0; i < int(beaconReq.Beacon.Count); i++ {
-			beacon, err := deployBeacon()
+			beacon, err := deployBeacon(beaconReq.Beacon.WithExecution)
 			if err != nil {
 				return nil, err
 			}
@@ -520,16 +1265,105 @@ func (s *Server) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (
 	return resp, nil
 }
 
+// validateNodeDeploy checks the parameters of the request that do not depend on the nodes
+func (s *Server) validateNodeDeploy(req *proto.NodeDeployRequest) error {
+	if err := validatePresetImage(s.config.Spec.Preset, req); err != nil {
+		return err
+	}
+	if valReq, ok := req.NodeType.(*proto.NodeDeployRequest_Validator_); ok && valReq.Validator.RemoteSigner && !remoteSignerClients[req.NodeClient] {
+		return fmt.Errorf("client %s does not support a remote signer", req.NodeClient)
+	}
+	return nil
+}
+
+var restartPolicies = map[proto.RestartPolicy]spec.RestartPolicy{
+	proto.RestartPolicy_Never:     spec.RestartNever,
+	proto.RestartPolicy_OnFailure: spec.RestartOnFailure,
+	proto.RestartPolicy_Always:    spec.RestartAlways,
+}
+
 var beaconFactory = map[proto.NodeClient]proto.CreateBeacon2{
 	proto.NodeClient_Teku:       components.NewTekuBeacon,
 	proto.NodeClient_Prysm:      components.NewPrysmBeacon,
 	proto.NodeClient_Lighthouse: components.NewLighthouseBeacon,
+	proto.NodeClient_Nimbus:     components.NewNimbusBeacon,
+	proto.NodeClient_Lodestar:   components.NewLodestarBeacon,
 }
 
 var validatorsFactory = map[proto.NodeClient]proto.CreateValidator2{
 	proto.NodeClient_Teku:       components.NewTekuValidator,
 	proto.NodeClient_Prysm:      components.NewPrysmValidator,
 	proto.NodeClient_Lighthouse: components.NewLighthouseValidator,
+	proto.NodeClient_Nimbus:     components.NewNimbusValidator,
+	proto.NodeClient_Lodestar:   components.NewLodestarValidator,
+}
+
+// presetRuntimeClients are the consensus clients that load the preset of
+// the network at runtime, either from the config or with a flag
+var presetRuntimeClients = map[proto.NodeClient]bool{
+	proto.NodeClient_Teku:     true,
+	proto.NodeClient_Lodestar: true,
+}
+
+type presetImage struct {
+	repo string
+	tag  string
+}
+
+// presetImages are the images of the consensus clients that set the preset when
+// they are built, for each preset other than mainnet and each type of node
+var presetImages = map[string]map[proto.NodeClient]map[string]presetImage{
+	genesis.MinimalPreset.Name: {
+		proto.NodeClient_Prysm: {
+			proto.NodeType_Beacon.String():    {"ethpandaops/prysm-beacon-chain", "develop-minimal"},
+			proto.NodeType_Validator.String(): {"ethpandaops/prysm-validator", "develop-minimal"},
+		},
+		proto.NodeClient_Lighthouse: {
+			proto.NodeType_Beacon.String():    {"ethpandaops/lighthouse", "stable-minimal"},
+			proto.NodeType_Validator.String(): {"ethpandaops/lighthouse", "stable-minimal"},
+		},
+		proto.NodeClient_Nimbus: {
+			proto.NodeType_Beacon.String():    {"ethpandaops/nimbus-eth2", "stable-minimal"},
+			proto.NodeType_Validator.String(): {"ethpandaops/nimbus-eth2", "stable-minimal"},
+		},
+	},
+}
+
+// validatePresetImage checks that the nodes of the request can run with the
+// preset of the network. The clients that set the preset when they are built
+// use their image of the preset or an image with the name of the preset in the tag.
+func validatePresetImage(preset string, req *proto.NodeDeployRequest) error {
+	if preset == genesis.MainnetPreset.Name || presetRuntimeClients[req.NodeClient] {
+		return nil
+	}
+	if _, ok := req.NodeType.(*proto.NodeDeployRequest_Execution_); ok {
+		return nil
+	}
+	if _, ok := presetImages[preset][req.NodeClient]; !ok {
+		return fmt.Errorf("client %s does not support the %s preset", req.NodeClient, preset)
+	}
+	if req.Repo == "" && req.Tag == "" {
+		return nil
+	}
+	if !strings.Contains(req.Tag, preset) {
+		return fmt.Errorf("client %s requires an image built with the %s preset, the tag '%s' has to include '%s'", req.NodeClient, preset, req.Tag, preset)
+	}
+	return nil
+}
+
+// remoteSignerClients are the validator clients that can sign
+// with a web3signer remote signer
+var remoteSignerClients = map[proto.NodeClient]bool{
+	proto.NodeClient_Prysm:      true,
+	proto.NodeClient_Lighthouse: true,
+	proto.NodeClient_Teku:       true,
+}
+
+var executionFactory = map[proto.ExecutionClient]proto.CreateExecution{
+	proto.ExecutionClient_Geth:       components.NewGethExecution,
+	proto.ExecutionClient_Nethermind: components.NewNethermindExecution,
+	proto.ExecutionClient_Besu:       components.NewBesuExecution,
+	proto.ExecutionClient_Erigon:     components.NewErigonExecution,
 }
 
 func (s *Server) NodeList(ctx context.Context, req *proto.NodeListRequest) (*proto.NodeListResponse, error) {
@@ -540,7 +1374,7 @@ func (s *Server) NodeList(ctx context.Context, req *proto.NodeListRequest) (*pro
 		Node: []*proto.Node{},
 	}
 	for _, n := range s.nodes {
-		stub, err := specNodeToNode(n)
+		stub, err := s.nodeToProtoLocked(n)
 		if err != nil {
 			return nil, err
 		}
@@ -554,24 +1388,221 @@ func (s *Server) NodeStatus(ctx context.Context, req *proto.NodeStatusRequest) (
 		return nil, fmt.Errorf("name is empty")
 	}
 
+	s.lock.Lock()
+	target, resp, err := s.nodeStatusLocked(req.Name)
+	s.lock.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// query the runtime and the beacon API without the lock since they might be slow
+	info, err := target.Info()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node info: %v", err)
+	}
+	resp.Container = &proto.ContainerStatus{
+		Id:        target.ID(),
+		Image:     info.Image,
+		State:     info.State,
+		StartedAt: info.StartedAt.UnixMilli(),
+		Mounts:    info.Mounts,
+		Ports:     info.Ports,
+	}
+	if info.State == "running" {
+		resp.Container.Uptime = uint64(time.Since(info.StartedAt).Seconds())
+	}
+
+	if resp.Node.Type == proto.NodeType_Beacon {
+		resp.Beacon = newBeaconAPI(target.GetAddr(proto.NodePortHttp)).status()
+	}
+	return resp, nil
+}
+
+// nodeStatusLocked returns the node and the part of its status tracked by the server
+func (s *Server) nodeStatusLocked(name string) (spec.Node, *proto.NodeStatusResponse, error) {
+	target, err := s.findNodeLocked(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	stub, err := s.nodeToProtoLocked(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp := &proto.NodeStatusResponse{
+		Node: stub,
+	}
+
+	if stub.Type == proto.NodeType_Validator {
+		// list the accounts of the tranche used by the validator
+		for index, tranche := range s.tranches {
+			if tranche.Validator != name {
+				continue
+			}
+			resp.Validator = &proto.ValidatorStatus{
+				Tranche: index,
+			}
+			for _, acct := range tranche.Accounts {
+				pubKey := acct.Bls.PubKey()
+				resp.Validator.PubKeys = append(resp.Validator.PubKeys, hex.EncodeToString(pubKey[:]))
+			}
+		}
+	}
+	return target, resp, nil
+}
+
+func (s *Server) NodeStop(ctx context.Context, req *proto.NodeStopRequest) (*proto.NodeStopResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.persist()
+
+	node, err := s.findNodeLocked(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("stop node", "name", req.Name)
+
+	s.status[req.Name].stop()
+	if err := node.Stop(); err != nil {
+		return nil, err
+	}
+	return &proto.NodeStopResponse{}, nil
+}
+
+func (s *Server) NodeStart(ctx context.Context, req *proto.NodeStartRequest) (*proto.NodeStartResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.persist()
+
+	node, err := s.findNodeLocked(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("start node", "name", req.Name)
+
+	if err := s.startNode(node); err != nil {
+		return nil, err
+	}
+	return &proto.NodeStartResponse{}, nil
+}
+
+func (s *Server) NodeRestart(ctx context.Context, req *proto.NodeRestartRequest) (*proto.NodeRestartResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer s.persist()
+
+	node, err := s.findNodeLocked(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("restart node", "name", req.Name)
+
+	s.status[req.Name].stop()
+	if err := node.Stop(); err != nil {
+		return nil, err
+	}
+	if err := s.startNode(node); err != nil {
+		return nil, err
+	}
+	return &proto.NodeRestartResponse{}, nil
+}
+
+// startNode starts again a stopped node
+func (s *Server) startNode(node spec.Node) error {
+	status := s.status[node.Spec().Name]
+
+	gen := status.start()
+	if err := node.Start(); err != nil {
+		status.setState(gen, proto.NodeState_Exited)
+		return err
+	}
+	if node.Spec().Retry == nil {
+		s.publishNodeReady(node)
+	}
+	s.watchNode(node, status, gen)
+	return nil
+}
+
+func (s *Server) NodeRemove(ctx context.Context, req *proto.NodeRemoveRequest) (*proto.NodeRemoveResponse, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	defer s.persist()
 
-	var target spec.Node
+	node, err := s.findNodeLocked(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.removeNodePairLocked(node); err != nil {
+		return nil, err
+	}
+	return &proto.NodeRemoveResponse{}, nil
+}
+
+// removeNodePairLocked removes the node and the node deployed as its pair.
+// Beacon and execution nodes, and validators and their signers, are
+// deployed as a pair and they are removed together.
+func (s *Server) removeNodePairLocked(node spec.Node) error {
+	if err := s.removeNodeLocked(node); err != nil {
+		return err
+	}
+	if pair := node.Spec().Labels[proto.NodePairLabel]; pair != "" {
+		if pairNode, err := s.findNodeLocked(pair); err == nil {
+			if err := s.removeNodeLocked(pairNode); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) removeNodeLocked(node spec.Node) error {
+	name := node.Spec().Name
+	s.logger.Info("remove node", "name", name)
+
+	s.status[name].stop()
+	if err := node.Remove(); err != nil {
+		return err
+	}
+	delete(s.status, name)
+	for i, n := range s.nodes {
+		if n == node {
+			s.nodes = append(s.nodes[:i], s.nodes[i+1:]...)
+			break
+		}
+	}
+
+	// release the tranche used by the validator so that
+	// the keys can be used by another validator
+	for _, tranche := range s.tranches {
+		if tranche.Validator == name {
+			tranche.Validator = ""
+		}
+	}
+	return nil
+}
+
+func (s *Server) findNodeLocked(name string) (spec.Node, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name is empty")
+	}
 	for _, n := range s.nodes {
-		if n.Spec().Name == req.Name {
-			target = n
+		if n.Spec().Name == name {
+			return n, nil
 		}
 	}
+	return nil, fmt.Errorf("node '%s' not found", name)
+}
 
-	stub, err := specNodeToNode(target)
+// nodeToProtoLocked returns the proto object of the node including its status
+func (s *Server) nodeToProtoLocked(n spec.Node) (*proto.Node, error) {
+	stub, err := specNodeToNode(n)
 	if err != nil {
 		return nil, err
 	}
-	resp := &proto.NodeStatusResponse{
-		Node: stub,
+	if status, ok := s.status[stub.Name]; ok {
+		status.fill(stub)
 	}
-	return resp, nil
+	return stub, nil
 }
 
 func specNodeToNode(n spec.Node) (*proto.Node, error) {
@@ -585,13 +1616,16 @@ func specNodeToNode(n spec.Node) (*proto.Node, error) {
 	if !ok {
 		clt = proto.NodeClient_OtherClient
 	}
+	execClt, _ := proto.StringToExecutionClient(spec.Labels[proto.ExecutionClientLabel])
 
 	resp := &proto.Node{
-		Name:   spec.Name,
-		Type:   typ,
-		Client: clt,
-		Ip:     n.IP(),
-		Labels: spec.Labels,
+		Name:            spec.Name,
+		Type:            typ,
+		Client:          clt,
+		Ip:              n.IP(),
+		Labels:          spec.Labels,
+		Pair:            spec.Labels[proto.NodePairLabel],
+		ExecutionClient: execClt,
 	}
 	return resp, nil
 }
@@ -603,13 +1637,16 @@ type logDir struct {
 	logFiles []*os.File
 }
 
-func newLogDir(path string) (*logDir, error) {
-	pwdPath, err := os.Getwd()
-	if err != nil {
-		return nil, err
+func newLogDir(dataDir, path string) (*logDir, error) {
+	if dataDir == "" {
+		pwdPath, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		dataDir = pwdPath
 	}
 
-	path = filepath.Join(pwdPath, path)
+	path = filepath.Join(dataDir, path)
 	if err := os.Mkdir(path, 0755); err != nil {
 		// it fails if path already exists
 		return nil, err
@@ -621,14 +1658,50 @@ func newLogDir(path string) (*logDir, error) {
 	return logDir, nil
 }
 
+// openLogDir opens the log dir of an existing network
+func openLogDir(dataDir, path string) (*logDir, error) {
+	if dataDir == "" {
+		pwdPath, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		dataDir = pwdPath
+	}
+
+	path = filepath.Join(dataDir, path)
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	logDir := &logDir{
+		path: path,
+	}
+	return logDir, nil
+}
+
 func (l *logDir) writeFile(path string, content []byte) (string, error) {
+	return l.writeFileMode(path, content, 0644)
+}
+
+// writeFileMode writes a file with the given permissions, which
+// are also set if the file already exists with other ones
+func (l *logDir) writeFileMode(path string, content []byte, mode os.FileMode) (string, error) {
 	fullPath := filepath.Join(l.path, path)
 
 	parentDir := filepath.Dir(fullPath)
 	if err := os.MkdirAll(parentDir, 0700); err != nil {
 		return "", err
 	}
-	if err := ioutil.WriteFile(fullPath, []byte(content), 0644); err != nil {
+	file, err := os.OpenFile(fullPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if err := file.Chmod(mode); err != nil {
+		return "", err
+	}
+	if _, err := file.Write(content); err != nil {
 		return "", err
 	}
 	return fullPath, nil
@@ -0,0 +1,926 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+	"github.com/umbracle/viewpoint/internal/components"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+func newTestServer(t *testing.T) (*Server, *fake.Fake) {
+	runtime := fake.NewFake()
+
+	key, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	srv := &Server{
+		config:   DefaultConfig(),
+		logger:   hclog.NewNullLogger(),
+		runtime:  runtime,
+		nodes:    []spec.Node{},
+		status:   map[string]*nodeStatus{},
+		logDir:   &logDir{path: t.TempDir()},
+		tranches: map[uint64]*Tranche{},
+		events:   newEventBroker(),
+		// the deposit handler is not connected to any eth1 node
+		depositHandler: &depositHandler{
+			key:   key,
+			nonce: -1,
+		},
+	}
+	t.Cleanup(srv.Stop)
+
+	return srv, runtime
+}
+
+func TestServer_NodeDeployBeacon(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		Tag:        "custom",
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 2,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+
+	assert.Equal(t, "beacon-0-teku", resp.Nodes[0].Name)
+	assert.Equal(t, "beacon-1-teku", resp.Nodes[1].Name)
+
+	specs := runtime.Specs()
+	require.Len(t, specs, 2)
+
+	for _, spec := range specs {
+		assert.Equal(t, "custom", spec.Tag)
+		assert.True(t, spec.HasLabel("viewpoint", "true"))
+		assert.True(t, spec.HasLabel("env", srv.config.Name))
+		assert.True(t, spec.HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()))
+	}
+
+	list, err := srv.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Node, 2)
+}
+
+func TestServer_NodeDeployBeacon_WithExecutionFails(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	srv.eth1Genesis = "{}"
+
+	eth1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer eth1.Close()
+
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortEth1Http, eth1.URL)
+	}
+
+	factory := beaconFactory[proto.NodeClient_Teku]
+	beaconFactory[proto.NodeClient_Teku] = func(cfg *proto.BeaconConfig) (*spec.Spec, error) {
+		return nil, fmt.Errorf("failed")
+	}
+	defer func() {
+		beaconFactory[proto.NodeClient_Teku] = factory
+	}()
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count:         1,
+				WithExecution: true,
+			},
+		},
+	}
+	_, err := srv.NodeDeploy(context.Background(), req)
+	require.Error(t, err)
+
+	// the execution node of the beacon node is removed
+	nodes := runtime.Nodes()
+	require.Len(t, nodes, 1)
+	assert.True(t, nodes[0].IsRemoved())
+
+	resp, err := srv.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Node)
+}
+
+func TestServer_NodeDeployBeacon_WithExecution(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	srv.bootnodeEC = "enode://bootnode"
+	srv.eth1Genesis = "{}"
+
+	// the execution node is ready once its http endpoint is reachable
+	eth1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer eth1.Close()
+
+	runtime.Hook = func(n *fake.Node) {
+		if n.Spec().HasLabel(proto.NodeTypeLabel, proto.NodeType_Execution.String()) {
+			n.SetAddr(proto.NodePortEth1Http, eth1.URL)
+		}
+	}
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		Tag:        "custom",
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count:         1,
+				WithExecution: true,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+
+	execution, beacon := resp.Nodes[0], resp.Nodes[1]
+	assert.Equal(t, "execution-0-geth", execution.Name)
+	assert.Equal(t, proto.NodeType_Execution, execution.Type)
+	assert.Equal(t, "beacon-0-teku", execution.Pair)
+	assert.Equal(t, "beacon-0-teku", beacon.Name)
+	assert.Equal(t, "execution-0-geth", beacon.Pair)
+
+	// the execution node peers through the bootnode and does not use the tag of the beacon
+	specs := runtime.Specs()
+	require.Len(t, specs, 2)
+	assert.NotEqual(t, "custom", specs[0].Tag)
+	assert.Contains(t, strings.Join(specs[0].Cmd, " "), "--bootnodes enode://bootnode")
+	assert.NotContains(t, strings.Join(specs[0].Cmd, " "), "--mine")
+
+	// the beacon node uses its own execution node
+	assert.Contains(t, specs[1].Cmd, eth1.URL)
+	nodes := runtime.Nodes()
+
+	// the pair is removed together
+	_, err = srv.NodeRemove(context.Background(), &proto.NodeRemoveRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+
+	assert.True(t, nodes[0].IsRemoved())
+	assert.True(t, nodes[1].IsRemoved())
+
+	list, err := srv.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Node, 0)
+}
+
+func TestServer_NodeDeployPreset(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	require.NoError(t, srv.config.Spec.ApplyPreset("minimal"))
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Prysm,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 1,
+			},
+		},
+	}
+
+	// prysm uses its image built with the minimal preset
+	_, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	// a custom image has to be a build of the minimal preset
+	req.Tag = "v2.1.0"
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.Error(t, err)
+
+	req.Repo = "prysm-minimal"
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.Error(t, err)
+
+	req.Repo, req.Tag = "", "v2.1.0-minimal"
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	// lodestar loads the preset at runtime
+	req.NodeClient = proto.NodeClient_Lodestar
+	req.Tag = ""
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	specs := runtime.Specs()
+	require.Len(t, specs, 3)
+	assert.Equal(t, "ethpandaops/prysm-beacon-chain", specs[0].Repository)
+	assert.Equal(t, "develop-minimal", specs[0].Tag)
+	assert.Equal(t, "gcr.io/prysmaticlabs/prysm/beacon-chain", specs[1].Repository)
+	assert.Equal(t, "v2.1.0-minimal", specs[1].Tag)
+	assert.Equal(t, "minimal", specs[2].Env["LODESTAR_PRESET"])
+}
+
+func TestServer_NodeDeployExecution(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	srv.bootnodeEC = "enode://bootnode"
+
+	genesis, _, err := components.NewDevGenesis()
+	require.NoError(t, err)
+	srv.eth1Genesis, err = genesis.Build()
+	require.NoError(t, err)
+
+	eth1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer eth1.Close()
+
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortEth1Http, eth1.URL)
+	}
+
+	req := &proto.NodeDeployRequest{
+		ExecutionClient: proto.ExecutionClient_Nethermind,
+		Tag:             "custom",
+		NodeType: &proto.NodeDeployRequest_Execution_{
+			Execution: &proto.NodeDeployRequest_Execution{
+				Count: 2,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+
+	for i, node := range resp.Nodes {
+		assert.Equal(t, fmt.Sprintf("execution-%d-nethermind", i), node.Name)
+		assert.Equal(t, proto.NodeType_Execution, node.Type)
+		assert.Equal(t, proto.ExecutionClient_Nethermind, node.ExecutionClient)
+		assert.Empty(t, node.Pair)
+	}
+
+	// the standalone execution nodes use the tag of the request
+	specs := runtime.Specs()
+	require.Len(t, specs, 2)
+	assert.Equal(t, "custom", specs[0].Tag)
+	assert.Contains(t, specs[0].Files, "/data/chainspec.json")
+
+	// a paired execution node uses the execution client of the request
+	req = &proto.NodeDeployRequest{
+		NodeClient:      proto.NodeClient_Lighthouse,
+		ExecutionClient: proto.ExecutionClient_Besu,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count:         1,
+				WithExecution: true,
+			},
+		},
+	}
+	resp, err = srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+	assert.Equal(t, "execution-2-besu", resp.Nodes[0].Name)
+	assert.Equal(t, "beacon-0-lighthouse", resp.Nodes[0].Pair)
+}
+
+func TestServer_NodeDeployValidator_Tranche(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	deployValidator := func() (*proto.NodeDeployResponse, error) {
+		req := &proto.NodeDeployRequest{
+			NodeClient: proto.NodeClient_Lighthouse,
+			NodeType: &proto.NodeDeployRequest_Validator_{
+				Validator: &proto.NodeDeployRequest_Validator{
+					NumTranch:   0,
+					WithBeacon:  true,
+					BeaconCount: 1,
+				},
+			},
+		}
+		return srv.NodeDeploy(context.Background(), req)
+	}
+
+	resp, err := deployValidator()
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+
+	assert.Equal(t, proto.NodeType_Beacon, resp.Nodes[0].Type)
+	assert.Equal(t, proto.NodeType_Validator, resp.Nodes[1].Type)
+
+	// the validator connects to the beacon node deployed with it
+	nodes := runtime.Nodes()
+	require.Len(t, nodes, 2)
+	assert.Contains(t, nodes[1].Spec().Cmd, nodes[0].GetAddr(proto.NodePortHttp))
+
+	// the tranche is consumed by the validator
+	assert.Equal(t, resp.Nodes[1].Name, srv.tranches[0].Validator)
+
+	_, err = deployValidator()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has already been used")
+}
+
+func TestServer_NodeDeployValidator_RemoteSigner(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	web3signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer web3signer.Close()
+
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortWeb3Signer, web3signer.URL)
+	}
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		Tag:        "22.10.0",
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				WithBeacon:   true,
+				BeaconCount:  1,
+				RemoteSigner: true,
+			},
+		},
+	}
+	resp, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 3)
+
+	signer, validator := resp.Nodes[1], resp.Nodes[2]
+	assert.Equal(t, proto.NodeType_Signer, signer.Type)
+	assert.Equal(t, "signer-0-web3signer", signer.Name)
+	assert.Equal(t, validator.Name, signer.Pair)
+	assert.Equal(t, signer.Name, validator.Pair)
+
+	// the validator signs with the signer
+	nodes := runtime.Nodes()
+	require.Len(t, nodes, 3)
+	assert.Contains(t, nodes[2].Spec().Cmd, web3signer.URL)
+
+	// the tag of the request only applies to the validator
+	assert.NotEqual(t, "22.10.0", nodes[1].Spec().Tag)
+	assert.Equal(t, "22.10.0", nodes[2].Spec().Tag)
+
+	// the signer is removed with the validator
+	_, err = srv.NodeRemove(context.Background(), &proto.NodeRemoveRequest{Name: validator.Name})
+	require.NoError(t, err)
+	assert.True(t, nodes[1].IsRemoved())
+	assert.False(t, srv.tranches[0].IsConsumed())
+
+	// nimbus does not support a remote signer
+	req.NodeClient = proto.NodeClient_Nimbus
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.Error(t, err)
+}
+
+func TestServer_NodeDeployValidator_UnknownTranche(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumTranch:   1,
+				WithBeacon:  true,
+				BeaconCount: 1,
+			},
+		},
+	}
+	_, err := srv.NodeDeploy(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not exists")
+}
+
+func TestServer_DeployNodeRetry(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	runtime.Hook = func(n *fake.Node) {
+		if n.Spec().Name == "bootnode-v4" {
+			n.WriteLogs("enode://abcd\n")
+		}
+	}
+
+	bootnode := components.NewBootnodeV4()
+	_, err := srv.deployNode(bootnode.Spec.WithName("bootnode-v4"))
+	require.NoError(t, err)
+	assert.Equal(t, "enode://abcd", bootnode.Enode)
+
+	// a node that exits before the retry function passes fails to deploy
+	runtime.Hook = func(n *fake.Node) {
+		n.Exit(nil)
+	}
+	_, err = srv.deployNode(components.NewBootnodeV5().Spec.WithName("bootnode"))
+	require.Error(t, err)
+}
+
+func TestServer_State(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	srv.depositHandler.deposit = ethgo.Address{0x1}
+	srv.depositHandler.nonce = 10
+	srv.bootnodeENR = "enr"
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	deposit := &proto.DepositStub{
+		PubKey:   "00",
+		Amount:   1000000000,
+		Invalid:  proto.InvalidDeposit_InvalidAmount,
+		Rejected: true,
+	}
+	srv.tranches[0].Deposits = []*proto.DepositStub{deposit}
+
+	_, err = srv.deployNode(components.NewBootnodeV4().Spec.WithName("bootnode-v4"))
+	require.NoError(t, err)
+
+	require.NoError(t, srv.saveState())
+
+	st, err := readState(srv.logDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, srv.config, st.Config)
+	assert.Equal(t, "enr", st.BootnodeENR)
+	assert.Equal(t, int64(10), st.DepositNonce)
+	assert.Equal(t, ethgo.Address{0x1}.String(), st.DepositContract)
+
+	// the accounts of the tranche are the same
+	tranche, err := st.Tranches[0].toTranche()
+	require.NoError(t, err)
+	require.Len(t, tranche.Accounts, 2)
+	for i, acct := range tranche.Accounts {
+		assert.True(t, acct.Bls.Equal(srv.tranches[0].Accounts[i].Bls))
+		assert.Equal(t, acct.Ecdsa.Address(), srv.tranches[0].Accounts[i].Ecdsa.Address())
+
+		// the uuid of the keystores is the same
+		assert.NotEmpty(t, acct.Bls.Id)
+		assert.Equal(t, srv.tranches[0].Accounts[i].Bls.Id, acct.Bls.Id)
+	}
+
+	// the deposits of the tranche are the same
+	require.Len(t, tranche.Deposits, 1)
+	assert.Equal(t, deposit.String(), tranche.Deposits[0].String())
+
+	// the node is attached with the same id
+	require.Len(t, st.Nodes, 1)
+	node, err := runtime.Attach(st.Nodes[0].toSpec(), st.Nodes[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-0", node.ID())
+	assert.Equal(t, "bootnode-v4", node.Spec().Name)
+	assert.True(t, node.Spec().HasLabel("env", srv.config.Name))
+
+	// the token is not stored and only the user can read the state
+	srv.config.GrpcToken = "secret"
+	require.NoError(t, srv.saveState())
+
+	data, err := ioutil.ReadFile(filepath.Join(srv.logDir.path, stateFile))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	info, err := os.Stat(filepath.Join(srv.logDir.path, stateFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestServer_Resume(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	srv.config.GrpcAddr = "localhost:0"
+
+	dataDir := t.TempDir()
+	logDir, err := newLogDir(dataDir, "e2e-"+srv.config.Name)
+	require.NoError(t, err)
+	srv.logDir = logDir
+
+	_, err = logDir.writeFile("genesis.ssz", []byte{0x1})
+	require.NoError(t, err)
+
+	eth1 := newTestEth1(t)
+	runtime.Hook = func(n *fake.Node) {
+		n.SetAddr(proto.NodePortEth1Http, eth1.URL)
+	}
+
+	for _, name := range []string{"eth1", "stopped", "crashed", "running"} {
+		_, err := srv.deployNode((&spec.Spec{}).WithName(name).WithContainer("node"))
+		require.NoError(t, err)
+	}
+	_, err = srv.createTranche(2)
+	require.NoError(t, err)
+
+	// the eth1 node and one node are stopped by the user and another one crashes
+	_, err = srv.NodeStop(context.Background(), &proto.NodeStopRequest{Name: "eth1"})
+	require.NoError(t, err)
+	_, err = srv.NodeStop(context.Background(), &proto.NodeStopRequest{Name: "stopped"})
+	require.NoError(t, err)
+
+	runtime.Nodes()[2].ExitWithCode(2, fmt.Errorf("crash"))
+	assert.Eventually(t, func() bool {
+		resp, err := srv.NodeStatus(context.Background(), &proto.NodeStatusRequest{Name: "crashed"})
+		return err == nil && resp.Node.State == proto.NodeState_Exited
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a network in another data dir is not found
+	_, err = ResumeServer(hclog.NewNullLogger(), &ResumeConfig{Name: srv.config.Name}, runtime)
+	require.Error(t, err)
+
+	resumed, err := ResumeServer(hclog.NewNullLogger(), &ResumeConfig{Name: srv.config.Name, DataDir: dataDir}, runtime)
+	require.NoError(t, err)
+	t.Cleanup(resumed.Stop)
+
+	assert.Len(t, resumed.tranches, 1)
+	assert.Equal(t, eth1.URL, resumed.eth1HttpAddr)
+
+	states := func() map[string]*proto.Node {
+		resp, err := resumed.NodeList(context.Background(), &proto.NodeListRequest{})
+		require.NoError(t, err)
+
+		nodes := map[string]*proto.Node{}
+		for _, node := range resp.Node {
+			nodes[node.Name] = node
+		}
+		return nodes
+	}
+
+	nodes := states()
+	require.Len(t, nodes, 4)
+
+	// the eth1 node is started again by the server
+	assert.Equal(t, proto.NodeState_Running, nodes["eth1"].State)
+	assert.Equal(t, proto.NodeState_Running, nodes["running"].State)
+	assert.Equal(t, proto.NodeState_Stopped, nodes["stopped"].State)
+	assert.Equal(t, proto.NodeState_Exited, nodes["crashed"].State)
+	assert.Equal(t, int64(2), nodes["crashed"].LastExit.ExitCode)
+	assert.Equal(t, "crash", nodes["crashed"].LastExit.Error)
+
+	// the nodes that are not running can be started again
+	_, err = resumed.NodeStart(context.Background(), &proto.NodeStartRequest{Name: "crashed"})
+	require.NoError(t, err)
+	assert.Equal(t, proto.NodeState_Running, states()["crashed"].State)
+}
+
+func TestServer_DerivedTranches(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.Mnemonic = "test test test test test test test test test test test junk"
+
+	var err error
+	srv.keySeed, err = srv.config.KeySeed()
+	require.NoError(t, err)
+
+	// each tranche takes the next range of indexes
+	_, err = srv.createTranche(2)
+	require.NoError(t, err)
+	_, err = srv.createTranche(3)
+	require.NoError(t, err)
+
+	resp, err := srv.DepositList(context.Background(), &proto.DepositListRequest{})
+	require.NoError(t, err)
+
+	indexes := map[uint64][]uint64{}
+	for _, tranche := range resp.Tranches {
+		for _, acct := range tranche.Accounts {
+			assert.True(t, acct.Derived)
+			indexes[tranche.Index] = append(indexes[tranche.Index], acct.Index)
+		}
+	}
+	assert.Equal(t, []uint64{0, 1}, indexes[0])
+	assert.Equal(t, []uint64{2, 3, 4}, indexes[1])
+
+	// the keys are the ones of the derivation index
+	acct, err := proto.NewDerivedAccount(srv.keySeed, 3)
+	require.NoError(t, err)
+	assert.True(t, acct.Bls.Equal(srv.tranches[1].Accounts[1].Bls))
+
+	// the derivation index is persisted
+	require.NoError(t, srv.saveState())
+	st, err := readState(srv.logDir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), st.AccountIndex)
+
+	tranche, err := st.Tranches[1].toTranche()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), *tranche.Accounts[1].Index)
+}
+
+func TestServer_NodeLifecycle(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 1,
+			},
+		},
+	}
+	_, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	node := runtime.Nodes()[0]
+
+	_, err = srv.NodeStop(context.Background(), &proto.NodeStopRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+	assert.True(t, node.IsStopped())
+
+	_, err = srv.NodeStart(context.Background(), &proto.NodeStartRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+	assert.False(t, node.IsStopped())
+
+	// a running node cannot be started again
+	_, err = srv.NodeStart(context.Background(), &proto.NodeStartRequest{Name: "beacon-0-teku"})
+	require.Error(t, err)
+
+	_, err = srv.NodeRestart(context.Background(), &proto.NodeRestartRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+	assert.False(t, node.IsStopped())
+
+	_, err = srv.NodeStop(context.Background(), &proto.NodeStopRequest{Name: "beacon-1-teku"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestServer_NodeRemove_ReleaseTranche(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	deployValidator := func() (*proto.NodeDeployResponse, error) {
+		req := &proto.NodeDeployRequest{
+			NodeClient: proto.NodeClient_Teku,
+			NodeType: &proto.NodeDeployRequest_Validator_{
+				Validator: &proto.NodeDeployRequest_Validator{
+					NumTranch:   0,
+					WithBeacon:  true,
+					BeaconCount: 1,
+				},
+			},
+		}
+		return srv.NodeDeploy(context.Background(), req)
+	}
+
+	resp, err := deployValidator()
+	require.NoError(t, err)
+
+	name := resp.Nodes[1].Name
+	assert.Equal(t, name, srv.tranches[0].Validator)
+
+	_, err = srv.NodeRemove(context.Background(), &proto.NodeRemoveRequest{Name: name})
+	require.NoError(t, err)
+
+	assert.True(t, runtime.Nodes()[1].IsRemoved())
+	assert.False(t, srv.tranches[0].IsConsumed())
+
+	list, err := srv.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Node, 1)
+
+	// the tranche can be used by a new validator
+	resp, err = deployValidator()
+	require.NoError(t, err)
+	assert.Equal(t, resp.Nodes[1].Name, srv.tranches[0].Validator)
+}
+
+func TestServer_NodeDeployValidator_TargetBeacon(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	_, err := srv.createTranche(1)
+	require.NoError(t, err)
+
+	_, err = srv.NodeDeploy(context.Background(), &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 2,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	deployValidator := func(beacon string) error {
+		req := &proto.NodeDeployRequest{
+			NodeClient: proto.NodeClient_Teku,
+			NodeType: &proto.NodeDeployRequest_Validator_{
+				Validator: &proto.NodeDeployRequest_Validator{
+					Beacon: beacon,
+				},
+			},
+		}
+		_, err := srv.NodeDeploy(context.Background(), req)
+		return err
+	}
+
+	require.Error(t, deployValidator("beacon-5-teku"))
+
+	require.NoError(t, deployValidator("beacon-1-teku"))
+
+	nodes := runtime.Nodes()
+	require.Len(t, nodes, 3)
+	assert.Contains(t, nodes[2].Spec().Cmd, nodes[1].GetAddr(proto.NodePortHttp))
+}
+
+func TestServer_NodeStatus(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	api := newTestBeaconAPI(t)
+
+	_, err := srv.createTranche(2)
+	require.NoError(t, err)
+
+	runtime.Hook = func(n *fake.Node) {
+		if n.Spec().HasLabel(proto.NodeTypeLabel, proto.NodeType_Beacon.String()) {
+			n.SetAddr(proto.NodePortHttp, api.URL)
+		}
+	}
+
+	req := &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Teku,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumTranch:   0,
+				WithBeacon:  true,
+				BeaconCount: 1,
+			},
+		},
+	}
+	_, err = srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	// beacon node
+	resp, err := srv.NodeStatus(context.Background(), &proto.NodeStatusRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+
+	require.NotNil(t, resp.Container)
+	assert.Equal(t, "fake-0", resp.Container.Id)
+	assert.Equal(t, "running", resp.Container.State)
+	assert.Equal(t, api.URL, resp.Container.Ports[proto.NodePortHttp])
+
+	require.NotNil(t, resp.Beacon)
+	assert.Empty(t, resp.Beacon.Error)
+	assert.Equal(t, uint64(120), resp.Beacon.HeadSlot)
+	assert.Nil(t, resp.Validator)
+
+	// validator node
+	resp, err = srv.NodeStatus(context.Background(), &proto.NodeStatusRequest{Name: "validator-0-teku"})
+	require.NoError(t, err)
+
+	assert.Nil(t, resp.Beacon)
+	require.NotNil(t, resp.Validator)
+	assert.Equal(t, uint64(0), resp.Validator.Tranche)
+	require.Len(t, resp.Validator.PubKeys, 2)
+
+	pubKey := srv.tranches[0].Accounts[0].Bls.PubKey()
+	assert.Equal(t, hex.EncodeToString(pubKey[:]), resp.Validator.PubKeys[0])
+}
+
+func TestServer_DepositCreate_TopUp(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	// the tranche to top up must exist
+	_, err := srv.DepositCreate(context.Background(), &proto.DepositCreateRequest{
+		TopUp:   true,
+		Tranche: 1,
+	})
+	require.Error(t, err)
+
+	// the withdrawal credentials of the validators cannot change
+	_, err = srv.createTranche(1)
+	require.NoError(t, err)
+
+	_, err = srv.DepositCreate(context.Background(), &proto.DepositCreateRequest{
+		TopUp:             true,
+		WithdrawalAddress: "0x1234567890123456789012345678901234567890",
+	})
+	require.Error(t, err)
+}
+
+func TestServer_DepositCreate_Unlocked(t *testing.T) {
+	srv, _ := newTestServer(t)
+	srv.config.Mnemonic = "test test test test test test test test test test test junk"
+
+	var err error
+	srv.keySeed, err = srv.config.KeySeed()
+	require.NoError(t, err)
+
+	eth1 := newTestEth1(t)
+	srv.depositHandler, _ = newTestDepositHandler(t, eth1)
+
+	// the eth1 node does not reply until it is unlocked
+	eth1.lock.Lock()
+
+	errCh := make(chan error)
+	go func() {
+		_, err := srv.DepositCreate(context.Background(), &proto.DepositCreateRequest{NumValidators: 2})
+		errCh <- err
+	}()
+
+	// the accounts are reserved and the server is not locked while the deposits are sent
+	assert.Eventually(t, func() bool {
+		srv.lock.Lock()
+		defer srv.lock.Unlock()
+		return srv.accountIndex == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = srv.NodeList(context.Background(), &proto.NodeListRequest{})
+	require.NoError(t, err)
+
+	// the tranche is created and it is busy until the deposits are sent
+	srv.lock.Lock()
+	require.Len(t, srv.tranches, 1)
+	tranche := srv.tranches[0]
+	assert.True(t, tranche.busy)
+	assert.Empty(t, tranche.Deposits)
+	srv.lock.Unlock()
+
+	// the tranche cannot be topped up or used by a validator while it is busy
+	_, err = srv.DepositCreate(context.Background(), &proto.DepositCreateRequest{
+		TopUp:   true,
+		Tranche: 0,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deposits in progress")
+
+	_, err = srv.NodeDeploy(context.Background(), &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Lighthouse,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumTranch:   0,
+				WithBeacon:  true,
+				BeaconCount: 1,
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deposits in progress")
+
+	eth1.lock.Unlock()
+	require.NoError(t, <-errCh)
+
+	require.Len(t, srv.tranches, 1)
+	assert.False(t, tranche.busy)
+	require.Len(t, tranche.Deposits, 2)
+	assert.Equal(t, uint64(1), *tranche.Accounts[1].Index)
+}
+
+func TestServer_DepositCreate_Partial(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	eth1 := newTestEth1(t)
+	srv.depositHandler, _ = newTestDepositHandler(t, eth1)
+
+	receiptTimeoutBlocks = 3
+	defer func() {
+		receiptTimeoutBlocks = 120
+	}()
+
+	// the node never mines the deposits
+	eth1.drop = func(txn *ethgo.Transaction, from ethgo.Address) bool {
+		return len(txn.Input) != 0
+	}
+
+	_, err := srv.DepositCreate(context.Background(), &proto.DepositCreateRequest{NumValidators: 2})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deposits of tranche 0 failed with 2 deposits sent")
+
+	// the tranche is saved with the deposits sent
+	require.Len(t, srv.tranches, 1)
+	tranche := srv.tranches[0]
+	assert.False(t, tranche.busy)
+	assert.Len(t, tranche.Accounts, 2)
+	assert.Len(t, tranche.Deposits, 2)
+}
+
+func TestServer_NodeDeployValidator_NewTranche(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	eth1 := newTestEth1(t)
+	srv.depositHandler, _ = newTestDepositHandler(t, eth1)
+
+	resp, err := srv.NodeDeploy(context.Background(), &proto.NodeDeployRequest{
+		NodeClient: proto.NodeClient_Lighthouse,
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumValidators: 2,
+				WithBeacon:    true,
+				BeaconCount:   1,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Nodes, 2)
+
+	// the validator uses the new tranche with its deposits
+	require.Len(t, srv.tranches, 1)
+	tranche := srv.tranches[0]
+	assert.Equal(t, resp.Nodes[1].Name, tranche.Validator)
+	assert.False(t, tranche.busy)
+	assert.Len(t, tranche.Deposits, 2)
+}
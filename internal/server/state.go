@@ -0,0 +1,221 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/umbracle/ethgo/wallet"
+	"github.com/umbracle/viewpoint/internal/genesis"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+const stateFile = "state.json"
+
+// state is the metadata of the network that is persisted in the e2e
+// folder to resume the server once it restarts
+type state struct {
+	Config          *Config
+	Spec            *eth2SpecState
+	DepositContract string
+	DepositKey      string
+	DepositNonce    int64
+	JwtSecret       string
+	BootnodeENR     string
+	BootnodeEC      string
+	Eth1Genesis     string
+	Tranches        map[uint64]*trancheState
+	Nodes           []*nodeState
+
+	// AccountIndex is the derivation index of the next account
+	AccountIndex uint64
+}
+
+// eth2SpecState is used to encode the Eth2Spec as json since
+// Eth2Spec marshals to the yaml config by default
+type eth2SpecState Eth2Spec
+
+type trancheState struct {
+	Accounts  []*accountState
+	Filepath  string
+	Validator string
+	Deposits  []*proto.DepositStub `json:",omitempty"`
+}
+
+type accountState struct {
+	Bls   string
+	Ecdsa string
+	Index *uint64 `json:",omitempty"`
+	ID    string  `json:",omitempty"`
+}
+
+type nodeState struct {
+	Name       string
+	ID         string
+	Repository string
+	Tag        string
+	Labels     map[string]string
+
+	RestartPolicy spec.RestartPolicy
+	MaxRetries    uint64
+
+	// Stopped is true if the node was stopped by the user
+	Stopped bool `json:",omitempty"`
+}
+
+func (s *Server) buildState() (*state, error) {
+	depositKey, err := s.depositHandler.key.MarshallPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// copy the config to decouple the spec from the config
+	config := *s.config
+	config.Spec = nil
+
+	st := &state{
+		Config:          &config,
+		Spec:            (*eth2SpecState)(s.config.Spec),
+		DepositContract: s.depositHandler.deposit.String(),
+		DepositKey:      hex.EncodeToString(depositKey),
+		DepositNonce:    atomic.LoadInt64(&s.depositHandler.nonce),
+		JwtSecret:       hex.EncodeToString(s.jwtSecret),
+		BootnodeENR:     s.bootnodeENR,
+		BootnodeEC:      s.bootnodeEC,
+		Eth1Genesis:     s.eth1Genesis,
+		Tranches:        map[uint64]*trancheState{},
+		Nodes:           []*nodeState{},
+		AccountIndex:    s.accountIndex,
+	}
+	for index, tranche := range s.tranches {
+		trancheSt := &trancheState{
+			Filepath:  tranche.Filepath,
+			Validator: tranche.Validator,
+			Deposits:  tranche.Deposits,
+		}
+		for _, acct := range tranche.Accounts {
+			blsKey, err := acct.Bls.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			ecdsaKey, err := acct.Ecdsa.MarshallPrivateKey()
+			if err != nil {
+				return nil, err
+			}
+			trancheSt.Accounts = append(trancheSt.Accounts, &accountState{
+				Bls:   hex.EncodeToString(blsKey),
+				Ecdsa: hex.EncodeToString(ecdsaKey),
+				Index: acct.Index,
+				ID:    acct.Bls.Id,
+			})
+		}
+		st.Tranches[index] = trancheSt
+	}
+	for _, node := range s.nodes {
+		spec := node.Spec()
+		nodeSt := &nodeState{
+			Name:       spec.Name,
+			ID:         node.ID(),
+			Repository: spec.Repository,
+			Tag:        spec.Tag,
+			Labels:     spec.Labels,
+
+			RestartPolicy: spec.RestartPolicy,
+			MaxRetries:    spec.MaxRetries,
+		}
+		if status, ok := s.status[spec.Name]; ok {
+			nodeSt.Stopped = status.isStopped()
+		}
+		st.Nodes = append(st.Nodes, nodeSt)
+	}
+	return st, nil
+}
+
+// saveState writes the state of the server in the e2e folder
+func (s *Server) saveState() error {
+	st, err := s.buildState()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(st, "", "\t")
+	if err != nil {
+		return err
+	}
+	// the state includes the keys of the network
+	if _, err := s.logDir.writeFileMode(stateFile, data, 0600); err != nil {
+		return err
+	}
+	return nil
+}
+
+func readState(logDir *logDir) (*state, error) {
+	data, err := ioutil.ReadFile(filepath.Join(logDir.path, stateFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state: %v", err)
+	}
+	var st state
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %v", err)
+	}
+	if st.Config == nil || st.Spec == nil {
+		return nil, fmt.Errorf("state does not include a config")
+	}
+	st.Config.Spec = (*Eth2Spec)(st.Spec)
+	if st.Config.Spec.Preset == "" {
+		// states written before the presets use the mainnet one
+		st.Config.Spec.Preset = genesis.MainnetPreset.Name
+	}
+	if st.Config.GrpcAddr == "" {
+		st.Config.GrpcAddr = DefaultConfig().GrpcAddr
+	}
+	return &st, nil
+}
+
+func (t *trancheState) toTranche() (*Tranche, error) {
+	tranche := &Tranche{
+		Accounts:  []*proto.Account{},
+		Filepath:  t.Filepath,
+		Validator: t.Validator,
+		Deposits:  t.Deposits,
+	}
+	for _, acct := range t.Accounts {
+		blsKey, err := hex.DecodeString(acct.Bls)
+		if err != nil {
+			return nil, err
+		}
+		ecdsaKey, err := hex.DecodeString(acct.Ecdsa)
+		if err != nil {
+			return nil, err
+		}
+		account := &proto.Account{
+			Index: acct.Index,
+		}
+		if account.Bls, err = proto.KeyFromPriv(blsKey); err != nil {
+			return nil, err
+		}
+		if acct.ID != "" {
+			// keep the uuid of the keystores of the key
+			account.Bls.Id = acct.ID
+		}
+		if account.Ecdsa, err = wallet.NewWalletFromPrivKey(ecdsaKey); err != nil {
+			return nil, err
+		}
+		tranche.Accounts = append(tranche.Accounts, account)
+	}
+	return tranche, nil
+}
+
+func (n *nodeState) toSpec() *spec.Spec {
+	spec := &spec.Spec{}
+	spec.WithName(n.Name).
+		WithContainer(n.Repository).
+		WithTag(n.Tag).
+		WithLabels(n.Labels).
+		WithRestartPolicy(n.RestartPolicy, n.MaxRetries)
+
+	return spec
+}
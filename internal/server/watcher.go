@@ -0,0 +1,229 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+// exitLogLines is the number of lines of logs stored in the e2e
+// folder every time a node exits
+const exitLogLines = 100
+
+// restartDelay is the time to wait before a node that exited is restarted
+var restartDelay = 1 * time.Second
+
+// nodeStatus is the state of a node as tracked by the server
+type nodeStatus struct {
+	lock     sync.Mutex
+	state    proto.NodeState
+	restarts uint64
+	lastExit *proto.NodeExit
+
+	// gen changes every time the node is started or stopped by the
+	// user so that the watchers of previous runs do not restart it
+	gen uint64
+}
+
+// start marks the node as running and returns the generation of the run
+func (n *nodeStatus) start() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.gen++
+	n.state = proto.NodeState_Running
+	return n.gen
+}
+
+// stop marks the node as stopped by the user
+func (n *nodeStatus) stop() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.gen++
+	n.state = proto.NodeState_Stopped
+}
+
+func (n *nodeStatus) isStopped() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.state == proto.NodeState_Stopped
+}
+
+func (n *nodeStatus) isCurrent(gen uint64) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.gen == gen
+}
+
+func (n *nodeStatus) setState(gen uint64, state proto.NodeState) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.gen == gen {
+		n.state = state
+	}
+}
+
+// exited records the exit of the node and returns true if the node
+// has to be restarted according to its restart policy
+func (n *nodeStatus) exited(gen uint64, nodeSpec *spec.Spec, result *spec.ExitResult, exit *proto.NodeExit) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.lastExit = exit
+	if n.gen != gen {
+		// the node was stopped by the user
+		return false
+	}
+	if !nodeSpec.RestartPolicy.ShouldRestart(result, n.restarts, nodeSpec.MaxRetries) {
+		n.state = proto.NodeState_Exited
+		return false
+	}
+	n.state = proto.NodeState_Restarting
+	n.restarts++
+	return true
+}
+
+// fill sets the status of the node in the proto object
+func (n *nodeStatus) fill(stub *proto.Node) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	stub.State = n.state
+	stub.Restarts = n.restarts
+	stub.LastExit = n.lastExit
+}
+
+// trackNode starts to track the status of a node that was just deployed
+func (s *Server) trackNode(node spec.Node) {
+	status := &nodeStatus{}
+	s.status[node.Spec().Name] = status
+
+	s.watchNode(node, status, status.start())
+}
+
+// trackExitedNode starts to track the status of a node that is not running.
+// The node is Stopped if it was stopped by the user or Exited otherwise.
+func (s *Server) trackExitedNode(node spec.Node, stopped bool) {
+	status := &nodeStatus{
+		state: proto.NodeState_Exited,
+	}
+	if stopped {
+		status.state = proto.NodeState_Stopped
+	}
+	if result := node.ExitResult(); result != nil {
+		status.lastExit = &proto.NodeExit{
+			ExitCode: result.ExitCode,
+		}
+		if result.Err != nil {
+			status.lastExit.Error = result.Err.Error()
+		}
+	}
+	s.status[node.Spec().Name] = status
+}
+
+// watchNode records every exit of the node and restarts it according
+// to its restart policy until it is stopped by the user
+func (s *Server) watchNode(node spec.Node, status *nodeStatus, gen uint64) {
+	waitCh := node.WaitCh()
+	name := node.Spec().Name
+
+	s.watchers.Add(1)
+	go func() {
+		defer s.watchers.Done()
+
+		for {
+			<-waitCh
+
+			result := node.ExitResult()
+			if result == nil {
+				result = &spec.ExitResult{}
+			}
+			exit := &proto.NodeExit{
+				ExitCode: result.ExitCode,
+				Time:     time.Now().UnixMilli(),
+			}
+			if result.Err != nil {
+				exit.Error = result.Err.Error()
+			}
+			if path, err := s.writeExitLogs(node); err != nil {
+				s.logger.Error("failed to write exit logs", "name", name, "err", err)
+			} else {
+				exit.Logs = path
+			}
+
+			stub, _ := specNodeToNode(node)
+			s.publish(&proto.Event{
+				Event: &proto.Event_NodeExited_{
+					NodeExited: &proto.Event_NodeExited{
+						Node:     stub,
+						Error:    exit.Error,
+						ExitCode: exit.ExitCode,
+					},
+				},
+			})
+
+			if !status.exited(gen, node.Spec(), result, exit) {
+				return
+			}
+
+			// a restart that fails counts as another attempt
+			for {
+				s.logger.Info("restart node", "name", name, "exit-code", exit.ExitCode, "err", exit.Error)
+				time.Sleep(restartDelay)
+
+				if !status.isCurrent(gen) {
+					// the node was stopped or started by the user in the meantime
+					return
+				}
+				err := node.Start()
+				if err == nil {
+					break
+				}
+				s.logger.Error("failed to restart node", "name", name, "err", err)
+
+				// the node might be running without being ready
+				if err := node.Stop(); err != nil {
+					s.logger.Error("failed to stop node", "name", name, "err", err)
+				}
+				exit = &proto.NodeExit{
+					ExitCode: -1,
+					Error:    fmt.Sprintf("failed to restart: %v", err),
+					Time:     time.Now().UnixMilli(),
+				}
+				result := &spec.ExitResult{ExitCode: exit.ExitCode, Err: err}
+				if !status.exited(gen, node.Spec(), result, exit) {
+					return
+				}
+			}
+			if node.Spec().Retry == nil {
+				s.publishNodeReady(node)
+			}
+			status.setState(gen, proto.NodeState_Running)
+
+			waitCh = node.WaitCh()
+		}
+	}()
+}
+
+// writeExitLogs writes the last lines of logs of the node in the e2e folder
+func (s *Server) writeExitLogs(node spec.Node) (string, error) {
+	logs, err := node.GetLogs()
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimRight(logs, "\n"), "\n")
+	if len(lines) > exitLogLines {
+		lines = lines[len(lines)-exitLogLines:]
+	}
+
+	path := fmt.Sprintf("exits/%s-%d.log", node.Spec().Name, time.Now().UnixNano())
+	return s.logDir.writeFile(path, []byte(strings.Join(lines, "\n")+"\n"))
+}
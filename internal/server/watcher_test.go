@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/fake"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"github.com/umbracle/viewpoint/internal/spec"
+)
+
+func deployTestBeacon(t *testing.T, srv *Server, runtime *fake.Fake, policy proto.RestartPolicy, maxRetries uint64) *fake.Node {
+	req := &proto.NodeDeployRequest{
+		NodeClient:    proto.NodeClient_Teku,
+		RestartPolicy: policy,
+		MaxRetries:    maxRetries,
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count: 1,
+			},
+		},
+	}
+	_, err := srv.NodeDeploy(context.Background(), req)
+	require.NoError(t, err)
+
+	nodes := runtime.Nodes()
+	return nodes[len(nodes)-1]
+}
+
+func testNodeStatus(t *testing.T, srv *Server, name string) *proto.Node {
+	resp, err := srv.NodeStatus(context.Background(), &proto.NodeStatusRequest{Name: name})
+	require.NoError(t, err)
+	return resp.Node
+}
+
+func TestServer_RestartOnFailure(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	node := deployTestBeacon(t, srv, runtime, proto.RestartPolicy_OnFailure, 2)
+	assert.Equal(t, proto.NodeState_Running, testNodeStatus(t, srv, "beacon-0-teku").State)
+
+	for i := 1; i <= 2; i++ {
+		node.WriteLogs(fmt.Sprintf("crash %d\n", i))
+		node.Exit(fmt.Errorf("failed"))
+
+		require.Eventually(t, func() bool {
+			status := testNodeStatus(t, srv, "beacon-0-teku")
+			return status.State == proto.NodeState_Running && status.Restarts == uint64(i)
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// the node is not restarted after the max number of retries
+	node.WriteLogs("crash 3\n")
+	node.Exit(fmt.Errorf("failed"))
+
+	require.Eventually(t, func() bool {
+		return testNodeStatus(t, srv, "beacon-0-teku").State == proto.NodeState_Exited
+	}, 5*time.Second, 50*time.Millisecond)
+
+	status := testNodeStatus(t, srv, "beacon-0-teku")
+	assert.Equal(t, uint64(2), status.Restarts)
+
+	exit := status.LastExit
+	require.NotNil(t, exit)
+	assert.Equal(t, int64(1), exit.ExitCode)
+	assert.Equal(t, "failed", exit.Error)
+
+	// the last lines of logs are stored in the e2e folder
+	logs, err := ioutil.ReadFile(exit.Logs)
+	require.NoError(t, err)
+	assert.Equal(t, "crash 1\ncrash 2\ncrash 3\n", string(logs))
+}
+
+func TestServer_RestartOnFailure_StartFails(t *testing.T) {
+	srv, runtime := newTestServer(t)
+	runtime.RetryTimeout = 50 * time.Millisecond
+
+	restartDelay = 10 * time.Millisecond
+	defer func() {
+		restartDelay = 1 * time.Second
+	}()
+
+	// the node is not ready the next failedStarts times it starts
+	var lock sync.Mutex
+	failedStarts := 0
+	starts := map[time.Time]bool{}
+
+	nodeSpec := func() *spec.Spec {
+		return (&spec.Spec{}).
+			WithName("node").
+			WithContainer("node").
+			WithRestartPolicy(spec.RestartOnFailure, 3).
+			WithRetry(func(n spec.Node) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				info, err := n.Info()
+				if err != nil {
+					return err
+				}
+				ready, ok := starts[info.StartedAt]
+				if !ok {
+					ready = failedStarts == 0
+					if !ready {
+						failedStarts--
+					}
+					starts[info.StartedAt] = ready
+				}
+				if !ready {
+					return fmt.Errorf("not ready")
+				}
+				return nil
+			})
+	}
+	_, err := srv.deployNode(nodeSpec())
+	require.NoError(t, err)
+
+	node := runtime.Nodes()[0]
+
+	// the node is restarted after two failed starts
+	lock.Lock()
+	failedStarts = 2
+	lock.Unlock()
+	node.Exit(fmt.Errorf("failed"))
+
+	require.Eventually(t, func() bool {
+		status := testNodeStatus(t, srv, "node")
+		return status.State == proto.NodeState_Running && status.Restarts == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the failed starts count as retries
+	srv2, runtime2 := newTestServer(t)
+	runtime2.RetryTimeout = 50 * time.Millisecond
+
+	_, err = srv2.deployNode(nodeSpec())
+	require.NoError(t, err)
+
+	lock.Lock()
+	failedStarts = 10
+	lock.Unlock()
+	runtime2.Nodes()[0].Exit(fmt.Errorf("failed"))
+
+	require.Eventually(t, func() bool {
+		status := testNodeStatus(t, srv2, "node")
+		return status.State == proto.NodeState_Exited && status.Restarts == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	exit := testNodeStatus(t, srv2, "node").LastExit
+	assert.Equal(t, int64(-1), exit.ExitCode)
+	assert.Contains(t, exit.Error, "failed to restart")
+}
+
+func TestServer_RestartPolicy_Exit(t *testing.T) {
+	cases := []struct {
+		policy proto.RestartPolicy
+		err    error
+		state  proto.NodeState
+	}{
+		{proto.RestartPolicy_Never, fmt.Errorf("failed"), proto.NodeState_Exited},
+		{proto.RestartPolicy_OnFailure, nil, proto.NodeState_Exited},
+		{proto.RestartPolicy_Always, nil, proto.NodeState_Running},
+	}
+
+	for _, c := range cases {
+		srv, runtime := newTestServer(t)
+
+		node := deployTestBeacon(t, srv, runtime, c.policy, 0)
+		node.Exit(c.err)
+
+		require.Eventually(t, func() bool {
+			status := testNodeStatus(t, srv, "beacon-0-teku")
+			return status.LastExit != nil && status.State == c.state
+		}, 5*time.Second, 50*time.Millisecond, c.policy.String())
+	}
+}
+
+func TestServer_RestartPolicy_UserStop(t *testing.T) {
+	srv, runtime := newTestServer(t)
+
+	node := deployTestBeacon(t, srv, runtime, proto.RestartPolicy_Always, 0)
+
+	_, err := srv.NodeStop(context.Background(), &proto.NodeStopRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+
+	// the exit is recorded but the node is not restarted
+	require.Eventually(t, func() bool {
+		return testNodeStatus(t, srv, "beacon-0-teku").LastExit != nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	status := testNodeStatus(t, srv, "beacon-0-teku")
+	assert.Equal(t, proto.NodeState_Stopped, status.State)
+	assert.Equal(t, uint64(0), status.Restarts)
+	assert.True(t, node.IsStopped())
+
+	_, err = srv.NodeStart(context.Background(), &proto.NodeStartRequest{Name: "beacon-0-teku"})
+	require.NoError(t, err)
+	assert.Equal(t, proto.NodeState_Running, testNodeStatus(t, srv, "beacon-0-teku").State)
+}
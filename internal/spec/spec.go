@@ -4,14 +4,94 @@ import (
 	"encoding"
 	"encoding/json"
 	"io"
+	"sort"
+	"time"
 )
 
+// Runtime deploys a Spec and returns a handle to the running node
+type Runtime interface {
+	Deploy(spec *Spec) (Node, error)
+	// Attach returns a handle to a node with the given id that is
+	// already running (i.e. deployed by a previous server)
+	Attach(spec *Spec, id string) (Node, error)
+}
+
 type Node interface {
+	ID() string
 	GetAddr(port string) string
 	GetLogs() (string, error)
 	Spec() *Spec
 	IP() string
 	Stop() error
+	// Start starts again a node that was stopped. The node keeps
+	// the same mounted data.
+	Start() error
+	// Remove removes the node and any resource allocated for it
+	Remove() error
+	// WaitCh is closed once the node is not running anymore
+	WaitCh() <-chan struct{}
+	// ExitResult returns how the node exited. It is nil if the node is running.
+	ExitResult() *ExitResult
+	// Info returns the information of the runtime about the node
+	Info() (*NodeInfo, error)
+}
+
+// NodeInfo is the information of the runtime about a node
+type NodeInfo struct {
+	// Image is the image (or binary) run by the node
+	Image string
+	// State is the state of the node in the runtime (i.e. running)
+	State string
+	// StartedAt is the time when the node was last started
+	StartedAt time.Time
+	// Mounts maps the paths mounted in the node to the paths in the host
+	Mounts map[string]string
+	// Ports maps the name of the ports used by the node to their address
+	Ports map[string]string
+}
+
+// ExitResult is the result of a node that stopped running
+type ExitResult struct {
+	// ExitCode is the exit code of the node
+	ExitCode int64
+	// Err is the error the node exited with if any
+	Err error
+}
+
+// Failed returns true if the node did not exit successfully
+func (e *ExitResult) Failed() bool {
+	return e.ExitCode != 0 || e.Err != nil
+}
+
+// RestartPolicy is the policy to restart a node once it exits
+type RestartPolicy string
+
+const (
+	// RestartNever does not restart the node
+	RestartNever RestartPolicy = "never"
+
+	// RestartOnFailure restarts the node if it exits with an error
+	RestartOnFailure RestartPolicy = "on-failure"
+
+	// RestartAlways restarts the node every time it exits
+	RestartAlways RestartPolicy = "always"
+)
+
+// ShouldRestart returns true if a node that exited with the given result and
+// was already restarted the given number of times has to be restarted again.
+// A zero maxRetries does not limit the restarts.
+func (r RestartPolicy) ShouldRestart(result *ExitResult, restarts, maxRetries uint64) bool {
+	switch r {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		if !result.Failed() {
+			return false
+		}
+		return maxRetries == 0 || restarts < maxRetries
+	default:
+		return false
+	}
 }
 
 type Spec struct {
@@ -26,6 +106,10 @@ type Spec struct {
 	Labels     map[string]string
 	User       string
 	Entrypoint []string
+	Env        map[string]string
+
+	RestartPolicy RestartPolicy
+	MaxRetries    uint64
 }
 
 func (s *Spec) HasLabel(k, v string) bool {
@@ -60,6 +144,12 @@ func (s *Spec) WithRetry(retry func(n Node) error) *Spec {
 	return s
 }
 
+func (s *Spec) WithRestartPolicy(policy RestartPolicy, maxRetries uint64) *Spec {
+	s.RestartPolicy = policy
+	s.MaxRetries = maxRetries
+	return s
+}
+
 func (s *Spec) WithEntrypoint(entrypoint []string) *Spec {
 	s.Entrypoint = entrypoint
 	return s
@@ -96,6 +186,25 @@ func (s *Spec) WithLabels(m map[string]string) *Spec {
 	return s
 }
 
+func (s *Spec) WithEnv(k, v string) *Spec {
+	if len(s.Env) == 0 {
+		s.Env = map[string]string{}
+	}
+	s.Env[k] = v
+	return s
+}
+
+// EnvList returns the environment variables of the spec
+// in the 'key=value' form sorted by key
+func (s *Spec) EnvList() []string {
+	env := []string{}
+	for k, v := range s.Env {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	return env
+}
+
 func (s *Spec) WithUser(user string) *Spec {
 	s.User = user
 	return s
@@ -0,0 +1,519 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"google.golang.org/grpc"
+)
+
+// DefaultAddr is the default address of the Viewpoint server
+const DefaultAddr = "localhost:5555"
+
+type (
+	// Node is a node deployed in the network
+	Node = proto.Node
+
+	// NodeClient is the client of a node (i.e. Lighthouse)
+	NodeClient = proto.NodeClient
+
+	// ExecutionClient is the client of an execution node (i.e. Geth)
+	ExecutionClient = proto.ExecutionClient
+
+	// NodeType is the type of a node (i.e. Beacon)
+	NodeType = proto.NodeType
+
+	// NodeStatus is the detailed status of a node
+	NodeStatus = proto.NodeStatusResponse
+
+	// NodeState is the state of a node (i.e. Running)
+	NodeState = proto.NodeState
+
+	// RestartPolicy is the policy to restart a node once it exits
+	RestartPolicy = proto.RestartPolicy
+
+	// Tranche is a set of validator accounts
+	Tranche = proto.TrancheStub
+
+	// Account is a validator account of a tranche
+	Account = proto.AccountStub
+
+	// Deposit is a deposit sent for an account of a tranche
+	Deposit = proto.DepositStub
+
+	// InvalidDeposit is the kind of invalid deposits of a tranche
+	InvalidDeposit = proto.InvalidDeposit
+
+	// ExportFormat is the format to export the accounts of a tranche
+	ExportFormat = proto.ExportFormat
+
+	// ExportFile is a file with the exported accounts of a tranche
+	ExportFile = proto.DepositExportResponse_File
+
+	// ExitStream is a stream with the status of the exited validators of a tranche
+	ExitStream = proto.E2EService_DepositExitClient
+
+	// Event is an event of the server
+	Event = proto.Event
+
+	// EventStream is a stream of events of the server
+	EventStream = proto.E2EService_SubscribeClient
+)
+
+const (
+	Teku       = proto.NodeClient_Teku
+	Prysm      = proto.NodeClient_Prysm
+	Lighthouse = proto.NodeClient_Lighthouse
+	Nimbus     = proto.NodeClient_Nimbus
+	Lodestar   = proto.NodeClient_Lodestar
+
+	Geth       = proto.ExecutionClient_Geth
+	Nethermind = proto.ExecutionClient_Nethermind
+	Besu       = proto.ExecutionClient_Besu
+	Erigon     = proto.ExecutionClient_Erigon
+
+	Beacon    = proto.NodeType_Beacon
+	Validator = proto.NodeType_Validator
+	Bootnode  = proto.NodeType_Bootnode
+	Execution = proto.NodeType_Execution
+	Signer    = proto.NodeType_Signer
+
+	Running    = proto.NodeState_Running
+	Exited     = proto.NodeState_Exited
+	Restarting = proto.NodeState_Restarting
+	Stopped    = proto.NodeState_Stopped
+
+	ExportKeystores   = proto.ExportFormat_Keystores
+	ExportDepositData = proto.ExportFormat_DepositData
+	ExportPrysmWallet = proto.ExportFormat_PrysmWallet
+	ExportRaw         = proto.ExportFormat_Raw
+
+	RestartNever     = proto.RestartPolicy_Never
+	RestartOnFailure = proto.RestartPolicy_OnFailure
+	RestartAlways    = proto.RestartPolicy_Always
+
+	InvalidSignature = proto.InvalidDeposit_InvalidSignature
+	InvalidRoot      = proto.InvalidDeposit_InvalidRoot
+	InvalidDuplicate = proto.InvalidDeposit_InvalidDuplicate
+	InvalidAmount    = proto.InvalidDeposit_InvalidAmount
+)
+
+// Client is a client for the Viewpoint server
+type Client struct {
+	conn *grpc.ClientConn
+	clt  proto.E2EServiceClient
+}
+
+// ClientOption is an option to configure the connection with the server
+type ClientOption func(c *proto.DialConfig)
+
+// WithTLS connects with TLS to a server with the certificate in caFile
+func WithTLS(caFile string) ClientOption {
+	return func(c *proto.DialConfig) {
+		c.CAFile = caFile
+	}
+}
+
+// WithToken sends the auth token of the server with every request
+func WithToken(token string) ClientOption {
+	return func(c *proto.DialConfig) {
+		c.Token = token
+	}
+}
+
+// NewClient creates a client connected to the Viewpoint server in addr.
+// The address can be a unix socket (unix:///path).
+func NewClient(addr string, opts ...ClientOption) (*Client, error) {
+	config := &proto.DialConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	conn, err := proto.Dial(addr, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %v", err)
+	}
+	clt := &Client{
+		conn: conn,
+		clt:  proto.NewE2EServiceClient(conn),
+	}
+	return clt, nil
+}
+
+// Close closes the connection with the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// BeaconOpts are the options to deploy beacon nodes
+type BeaconOpts struct {
+	// Count is the number of beacon nodes to deploy (default 1)
+	Count uint64
+
+	// WithExecution deploys a dedicated execution node for each beacon node
+	// with the ExecutionClient client (default Geth)
+	WithExecution   bool
+	ExecutionClient ExecutionClient
+
+	// Repo and Tag override the default container of the client
+	Repo string
+	Tag  string
+
+	// RestartPolicy is the policy to restart the nodes once they exit.
+	// MaxRetries limits the restarts with RestartOnFailure (0 is unlimited).
+	RestartPolicy RestartPolicy
+	MaxRetries    uint64
+}
+
+// DeployBeacon deploys beacon nodes of the given client
+func (c *Client) DeployBeacon(ctx context.Context, client NodeClient, opts *BeaconOpts) ([]*Node, error) {
+	if opts == nil {
+		opts = &BeaconOpts{}
+	}
+	count := opts.Count
+	if count == 0 {
+		count = 1
+	}
+	req := &proto.NodeDeployRequest{
+		NodeClient:      client,
+		ExecutionClient: opts.ExecutionClient,
+		Repo:            opts.Repo,
+		Tag:             opts.Tag,
+
+		RestartPolicy: opts.RestartPolicy,
+		MaxRetries:    opts.MaxRetries,
+
+		NodeType: &proto.NodeDeployRequest_Beacon_{
+			Beacon: &proto.NodeDeployRequest_Beacon{
+				Count:         count,
+				WithExecution: opts.WithExecution,
+			},
+		},
+	}
+	resp, err := c.clt.NodeDeploy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Nodes, nil
+}
+
+// ExecutionOpts are the options to deploy execution nodes
+type ExecutionOpts struct {
+	// Count is the number of execution nodes to deploy (default 1)
+	Count uint64
+
+	// Repo and Tag override the default container of the client
+	Repo string
+	Tag  string
+
+	// RestartPolicy is the policy to restart the nodes once they exit.
+	// MaxRetries limits the restarts with RestartOnFailure (0 is unlimited).
+	RestartPolicy RestartPolicy
+	MaxRetries    uint64
+}
+
+// DeployExecution deploys execution nodes of the given client that
+// sync the execution chain of the network
+func (c *Client) DeployExecution(ctx context.Context, client ExecutionClient, opts *ExecutionOpts) ([]*Node, error) {
+	if opts == nil {
+		opts = &ExecutionOpts{}
+	}
+	count := opts.Count
+	if count == 0 {
+		count = 1
+	}
+	req := &proto.NodeDeployRequest{
+		ExecutionClient: client,
+		Repo:            opts.Repo,
+		Tag:             opts.Tag,
+
+		RestartPolicy: opts.RestartPolicy,
+		MaxRetries:    opts.MaxRetries,
+
+		NodeType: &proto.NodeDeployRequest_Execution_{
+			Execution: &proto.NodeDeployRequest_Execution{
+				Count: count,
+			},
+		},
+	}
+	resp, err := c.clt.NodeDeploy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Nodes, nil
+}
+
+// ValidatorOpts are the options to deploy a validator node
+type ValidatorOpts struct {
+	// Tranche is the index of the tranche used by the validator
+	Tranche uint64
+
+	// NumValidators creates a new tranche (with deposits) for the validator
+	// instead of using an existing one
+	NumValidators uint64
+
+	// Beacon is the name of the beacon node the validator connects to.
+	// If empty, it connects to a beacon node of the same client.
+	Beacon string
+
+	// WithBeacon deploys BeaconCount beacon nodes for the validator
+	WithBeacon  bool
+	BeaconCount uint64
+
+	// BeaconWithExecution deploys a dedicated execution node with the
+	// BeaconExecutionClient client for each beacon node deployed with the validator
+	BeaconWithExecution   bool
+	BeaconExecutionClient ExecutionClient
+
+	// RemoteSigner deploys a web3signer node with the keys of the tranche
+	// and the validator signs through it. Only supported by the Prysm,
+	// Lighthouse and Teku clients.
+	RemoteSigner bool
+
+	// Repo and Tag override the default container of the client
+	Repo string
+	Tag  string
+
+	// RestartPolicy is the policy to restart the nodes once they exit.
+	// MaxRetries limits the restarts with RestartOnFailure (0 is unlimited).
+	RestartPolicy RestartPolicy
+	MaxRetries    uint64
+}
+
+// DeployValidator deploys a validator node of the given client. The response
+// includes the beacon nodes deployed with the validator.
+func (c *Client) DeployValidator(ctx context.Context, client NodeClient, opts *ValidatorOpts) ([]*Node, error) {
+	if opts == nil {
+		opts = &ValidatorOpts{}
+	}
+	beaconCount := opts.BeaconCount
+	if opts.WithBeacon && beaconCount == 0 {
+		beaconCount = 1
+	}
+	req := &proto.NodeDeployRequest{
+		NodeClient:      client,
+		ExecutionClient: opts.BeaconExecutionClient,
+		Repo:            opts.Repo,
+		Tag:             opts.Tag,
+
+		RestartPolicy: opts.RestartPolicy,
+		MaxRetries:    opts.MaxRetries,
+
+		NodeType: &proto.NodeDeployRequest_Validator_{
+			Validator: &proto.NodeDeployRequest_Validator{
+				NumValidators: opts.NumValidators,
+				NumTranch:     opts.Tranche,
+				WithBeacon:    opts.WithBeacon,
+				BeaconCount:   beaconCount,
+				Beacon:        opts.Beacon,
+
+				BeaconWithExecution: opts.BeaconWithExecution,
+				RemoteSigner:        opts.RemoteSigner,
+			},
+		},
+	}
+	resp, err := c.clt.NodeDeploy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Nodes, nil
+}
+
+// CreateTranche creates a new tranche of validators and makes their deposits
+func (c *Client) CreateTranche(ctx context.Context, numValidators uint64) (*Tranche, error) {
+	resp, err := c.clt.DepositCreate(ctx, &proto.DepositCreateRequest{NumValidators: numValidators})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tranche, nil
+}
+
+// DepositOpts are the options of the deposits of a tranche
+type DepositOpts struct {
+	// Amount is the value of each deposit in gwei. It defaults to the
+	// deposit of a validator with the max effective balance.
+	Amount uint64
+
+	// WithdrawalAddress sets execution (0x01) withdrawal credentials with the
+	// address instead of the BLS credentials of the validator key
+	WithdrawalAddress string
+
+	// Invalid makes deliberately invalid deposits of the kind. The
+	// deposits that are expected to be rejected are in Tranche.Deposits.
+	Invalid InvalidDeposit
+}
+
+// CreateTrancheWithDeposits creates a new tranche of validators and makes
+// their deposits with the options
+func (c *Client) CreateTrancheWithDeposits(ctx context.Context, numValidators uint64, opts *DepositOpts) (*Tranche, error) {
+	if opts == nil {
+		opts = &DepositOpts{}
+	}
+	req := &proto.DepositCreateRequest{
+		NumValidators:     numValidators,
+		Amount:            opts.Amount,
+		WithdrawalAddress: opts.WithdrawalAddress,
+		Invalid:           opts.Invalid,
+	}
+	if opts.WithdrawalAddress != "" {
+		req.WithdrawalCredentials = proto.WithdrawalCredentials_ExecutionCredentials
+	}
+	resp, err := c.clt.DepositCreate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tranche, nil
+}
+
+// TopUpTranche deposits the amount in gwei to each validator of an existing tranche
+func (c *Client) TopUpTranche(ctx context.Context, tranche uint64, amount uint64) (*Tranche, error) {
+	req := &proto.DepositCreateRequest{
+		TopUp:   true,
+		Tranche: tranche,
+		Amount:  amount,
+	}
+	resp, err := c.clt.DepositCreate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tranche, nil
+}
+
+// ImportTranche creates a new tranche with existing validator keys (the
+// serialized BLS private keys) and makes their deposits
+func (c *Client) ImportTranche(ctx context.Context, keys [][]byte) (*Tranche, error) {
+	resp, err := c.clt.DepositImport(ctx, &proto.DepositImportRequest{Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tranche, nil
+}
+
+// ExitTranche submits the voluntary exits of the validators of a tranche with the
+// given validator indices (all of them if empty). The stream receives the status
+// of the validators every time it changes and it is closed once all are exited.
+func (c *Client) ExitTranche(ctx context.Context, tranche uint64, indices []uint64) (ExitStream, error) {
+	req := &proto.DepositExitRequest{
+		Tranche: tranche,
+		Indices: indices,
+	}
+	return c.clt.DepositExit(ctx, req)
+}
+
+// Tranches returns all the tranches of the network
+func (c *Client) Tranches(ctx context.Context) ([]*Tranche, error) {
+	resp, err := c.clt.DepositList(ctx, &proto.DepositListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tranches, nil
+}
+
+// ExportTranche exports the accounts of a tranche in the given format. The
+// keystores are encrypted with the password or a random one if it is empty.
+func (c *Client) ExportTranche(ctx context.Context, tranche uint64, format ExportFormat, password string) ([]*ExportFile, error) {
+	req := &proto.DepositExportRequest{
+		Tranche:  tranche,
+		Format:   format,
+		Password: password,
+	}
+	resp, err := c.clt.DepositExport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// Nodes returns all the nodes of the network
+func (c *Client) Nodes(ctx context.Context) ([]*Node, error) {
+	resp, err := c.clt.NodeList(ctx, &proto.NodeListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Node, nil
+}
+
+// Node returns the node with the given name
+func (c *Client) Node(ctx context.Context, name string) (*Node, error) {
+	resp, err := c.clt.NodeStatus(ctx, &proto.NodeStatusRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Node, nil
+}
+
+// NodeStatus returns the status of the node with the given name, including
+// the runtime information and the live status of beacon and validator nodes
+func (c *Client) NodeStatus(ctx context.Context, name string) (*NodeStatus, error) {
+	return c.clt.NodeStatus(ctx, &proto.NodeStatusRequest{Name: name})
+}
+
+// Subscribe returns a stream with the events of the server from now on
+func (c *Client) Subscribe(ctx context.Context) (EventStream, error) {
+	return c.clt.Subscribe(ctx, &proto.SubscribeRequest{})
+}
+
+// StopNode stops the node with the given name
+func (c *Client) StopNode(ctx context.Context, name string) error {
+	_, err := c.clt.NodeStop(ctx, &proto.NodeStopRequest{Name: name})
+	return err
+}
+
+// StartNode starts again a stopped node
+func (c *Client) StartNode(ctx context.Context, name string) error {
+	_, err := c.clt.NodeStart(ctx, &proto.NodeStartRequest{Name: name})
+	return err
+}
+
+// RestartNode stops and starts the node with the given name
+func (c *Client) RestartNode(ctx context.Context, name string) error {
+	_, err := c.clt.NodeRestart(ctx, &proto.NodeRestartRequest{Name: name})
+	return err
+}
+
+// RemoveNode removes the node with the given name. The tranche of a
+// validator can be used again once it is removed.
+func (c *Client) RemoveNode(ctx context.Context, name string) error {
+	_, err := c.clt.NodeRemove(ctx, &proto.NodeRemoveRequest{Name: name})
+	return err
+}
+
+// MigrateOpts are the options of the new validator of a migration
+type MigrateOpts struct {
+	// Beacon is the name of the beacon node the new validator connects to.
+	// If empty, it connects to a beacon node of the same client.
+	Beacon string
+
+	// Repo and Tag override the default container of the client
+	Repo string
+	Tag  string
+
+	// RestartPolicy is the policy to restart the new validator once it exits.
+	// MaxRetries limits the restarts with RestartOnFailure (0 is unlimited).
+	RestartPolicy RestartPolicy
+	MaxRetries    uint64
+}
+
+// MigrateValidator stops the validator with the given name and deploys a validator
+// of another client with the same tranche. The slashing protection database of the
+// validator is exported and imported in the new one. It returns the new validator
+// and the EIP-3076 interchange file.
+func (c *Client) MigrateValidator(ctx context.Context, name string, client NodeClient, opts *MigrateOpts) (*Node, []byte, error) {
+	if opts == nil {
+		opts = &MigrateOpts{}
+	}
+	req := &proto.ValidatorMigrateRequest{
+		Name:          name,
+		NodeClient:    client,
+		Beacon:        opts.Beacon,
+		Repo:          opts.Repo,
+		Tag:           opts.Tag,
+		RestartPolicy: opts.RestartPolicy,
+		MaxRetries:    opts.MaxRetries,
+	}
+	resp, err := c.clt.ValidatorMigrate(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Node, resp.SlashingProtection, nil
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/viewpoint/internal/server/proto"
+	"google.golang.org/grpc"
+)
+
+// mockServer records the requests of the client
+type mockServer struct {
+	proto.UnimplementedE2EServiceServer
+
+	deployReqs []*proto.NodeDeployRequest
+	stopped    []string
+}
+
+func (m *mockServer) NodeDeploy(ctx context.Context, req *proto.NodeDeployRequest) (*proto.NodeDeployResponse, error) {
+	m.deployReqs = append(m.deployReqs, req)
+	return &proto.NodeDeployResponse{Nodes: []*proto.Node{{Name: "node", Client: req.NodeClient}}}, nil
+}
+
+func (m *mockServer) DepositCreate(ctx context.Context, req *proto.DepositCreateRequest) (*proto.DepositCreateResponse, error) {
+	tranche := &proto.TrancheStub{}
+	for i := 0; i < int(req.NumValidators); i++ {
+		tranche.Accounts = append(tranche.Accounts, &proto.AccountStub{})
+	}
+	return &proto.DepositCreateResponse{Tranche: tranche}, nil
+}
+
+func (m *mockServer) NodeStop(ctx context.Context, req *proto.NodeStopRequest) (*proto.NodeStopResponse, error) {
+	if req.Name != "node" {
+		return nil, fmt.Errorf("node '%s' not found", req.Name)
+	}
+	m.stopped = append(m.stopped, req.Name)
+	return &proto.NodeStopResponse{}, nil
+}
+
+func newMockClient(t *testing.T) (*Client, *mockServer) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &mockServer{}
+	grpcServer := grpc.NewServer()
+	proto.RegisterE2EServiceServer(grpcServer, srv)
+
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	clt, err := NewClient(lis.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		clt.Close()
+	})
+	return clt, srv
+}
+
+func TestClient_Deploy(t *testing.T) {
+	clt, srv := newMockClient(t)
+	ctx := context.Background()
+
+	nodes, err := clt.DeployBeacon(ctx, Teku, nil)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, Teku, nodes[0].Client)
+
+	_, err = clt.DeployValidator(ctx, Lighthouse, &ValidatorOpts{
+		Tranche:    1,
+		WithBeacon: true,
+		Tag:        "custom",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, srv.deployReqs, 2)
+	assert.Equal(t, uint64(1), srv.deployReqs[0].GetBeacon().Count)
+
+	req := srv.deployReqs[1]
+	assert.Equal(t, proto.NodeClient_Lighthouse, req.NodeClient)
+	assert.Equal(t, "custom", req.Tag)
+	assert.Equal(t, uint64(1), req.GetValidator().NumTranch)
+	assert.Equal(t, uint64(1), req.GetValidator().BeaconCount)
+}
+
+func TestClient_CreateTranche(t *testing.T) {
+	clt, _ := newMockClient(t)
+
+	tranche, err := clt.CreateTranche(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Len(t, tranche.Accounts, 3)
+}
+
+func TestClient_StopNode(t *testing.T) {
+	clt, srv := newMockClient(t)
+
+	require.NoError(t, clt.StopNode(context.Background(), "node"))
+	assert.Equal(t, []string{"node"}, srv.stopped)
+
+	err := clt.StopNode(context.Background(), "other")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/viewpoint/internal/docker"
+	"github.com/umbracle/viewpoint/internal/server"
+)
+
+type (
+	// Config is the config of the network started by the server
+	Config = server.Config
+
+	// Eth2Spec is the Eth2 spec of the network
+	Eth2Spec = server.Eth2Spec
+)
+
+// DefaultConfig returns the default config of a network
+func DefaultConfig() *Config {
+	return server.DefaultConfig()
+}
+
+// NewTestServer starts an embedded Viewpoint server with the Docker runtime
+// and returns a client connected to it. The config of the network can be
+// modified with the callback. The server and the nodes are stopped once
+// the test finishes.
+func NewTestServer(t testing.TB, callback func(c *Config)) *Client {
+	t.Helper()
+
+	config := server.DefaultConfig()
+	config.Name = strings.ReplaceAll(t.Name(), "/", "-")
+	config.DataDir = t.TempDir()
+	// use a random port to run tests in parallel
+	config.GrpcAddr = "localhost:0"
+	if callback != nil {
+		callback(config)
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "viewpoint",
+		Level: hclog.Info,
+	})
+
+	d, err := docker.NewDocker()
+	if err != nil {
+		t.Fatalf("failed to start docker runtime: %v", err)
+	}
+	d.SetLogger(logger.Named("docker"))
+
+	srv, err := server.NewServer(logger, config, d)
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	t.Cleanup(srv.Stop)
+
+	opts := []ClientOption{}
+	if certFile := srv.GrpcCertFile(); certFile != "" {
+		opts = append(opts, WithTLS(certFile))
+	}
+	if config.GrpcToken != "" {
+		opts = append(opts, WithToken(config.GrpcToken))
+	}
+	clt, err := NewClient(srv.GrpcAddr(), opts...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() {
+		clt.Close()
+	})
+	return clt
+}